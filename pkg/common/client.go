@@ -3,8 +3,10 @@ package common
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/connellrobert/printify-go/pkg/v1/pagination"
 )
@@ -94,6 +96,37 @@ func ListResourceWithId[T any, ID int | string](endpoint string) func(c *Client,
 		return resources.Data, nil
 	}
 }
+
+// ListPageWithId fetches a single page of a paginated list endpoint, appending query to the URL.
+func ListPageWithId[T any, ID int | string](endpoint string) func(c *Client, id ID, query url.Values) (*pagination.APIPagination[T], error) {
+	return func(c *Client, id ID, query url.Values) (*pagination.APIPagination[T], error) {
+		u := fmt.Sprintf(c.Host+endpoint, id)
+		if len(query) > 0 {
+			u += "?" + query.Encode()
+		}
+		req, err := http.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+		if c.PAT == "" {
+			return nil, fmt.Errorf("PAT is required")
+		}
+		req.Header.Add("Authorization", "Bearer "+c.PAT)
+		resp, err := checkResponse(c, req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		var page pagination.APIPagination[T]
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		if err != nil {
+			return nil, err
+		}
+		return &page, nil
+	}
+}
+
 func ListResourceWithTwoID[T any, IDONE, IDTWO int | string](endpoint string) func(c *Client, idOne IDONE, idTwo IDTWO) ([]T, error) {
 	return func(c *Client, idOne IDONE, idTwo IDTWO) ([]T, error) {
 		url := fmt.Sprintf(c.Host+endpoint, idOne, idTwo)
@@ -509,12 +542,32 @@ func checkResponse(c *Client, req *http.Request) (*http.Response, error) {
 		return nil, err
 	}
 	if resp.StatusCode >= 400 {
-		var r map[string]interface{}
-		err = json.NewDecoder(resp.Body).Decode(&r)
-		if err != nil {
-			return nil, err
+		defer resp.Body.Close()
+		statusErr := &StatusError{StatusCode: resp.StatusCode}
+		if err := json.NewDecoder(resp.Body).Decode(&statusErr.Body); err != nil {
+			statusErr.Body = nil
 		}
-		return nil, fmt.Errorf("error: %+v", r)
+		return nil, statusErr
 	}
 	return resp, nil
 }
+
+// StatusError is returned for responses with a 4xx or 5xx status.
+type StatusError struct {
+	StatusCode int
+	// Body is the decoded error response, or nil when it is not JSON.
+	Body map[string]interface{}
+}
+
+func (e *StatusError) Error() string {
+	if e.Body == nil {
+		return fmt.Sprintf("error: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("error: %+v", e.Body)
+}
+
+// IsNotFound reports whether err is a StatusError with status 404.
+func IsNotFound(err error) bool {
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound
+}
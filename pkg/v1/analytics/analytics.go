@@ -0,0 +1,162 @@
+package analytics
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+// LineProfit computes the profitability of a single line item.
+//
+// Revenue is the retail unit price (LineItemMetadata.Price) multiplied by Quantity.
+// Cost and ShippingCost are taken as reported for the whole line.
+func LineProfit(li order.LineItem) Breakdown {
+	b := Breakdown{
+		Revenue:        li.Metadata.Price.Mul(li.Quantity).Cents(),
+		ProductionCost: li.Cost.Cents(),
+		ShippingCost:   li.ShippingCost.Cents(),
+		Quantity:       li.Quantity,
+		Orders:         1,
+	}
+	b.finish()
+	return b
+}
+
+// OrderProfit computes the profitability of an order and each of its line items.
+//
+// The order's TotalShipping is used as its shipping cost when set, falling back to
+// the sum of line item shipping costs. The order's shipping cost is allocated to its
+// lines, so Lines add up to the order. TotalTax is reported as Tax.
+func OrderProfit(o order.Order) OrderBreakdown {
+	ob := OrderBreakdown{OrderId: o.Id, Lines: orderLines(o)}
+	for _, line := range ob.Lines {
+		ob.add(line)
+	}
+	ob.Tax = o.TotalTax.Cents()
+	ob.Orders = 1
+	ob.finish()
+	return ob
+}
+
+// Aggregate sums the profitability of orders grouped by product, SKU, print provider or month.
+//
+// Groups are returned sorted by key. Product, SKU and print provider groups are built
+// from the lines of OrderProfit, so shipping is counted the same way in every grouping
+// and groups add up to Total; they carry no Tax, which month groups and Total include.
+func Aggregate(orders []order.Order, by GroupByEnum) ([]Group, error) {
+	switch by {
+	case GroupByProduct, GroupBySku, GroupByPrintProvider, GroupByMonth:
+	default:
+		return nil, fmt.Errorf("unsupported grouping: %s", by)
+	}
+
+	groups := map[string]*Group{}
+	seen := map[string]map[string]bool{}
+	add := func(key, orderId string, b Breakdown) {
+		g, ok := groups[key]
+		if !ok {
+			g = &Group{Key: key}
+			groups[key] = g
+			seen[key] = map[string]bool{}
+		}
+		g.add(b)
+		seen[key][orderId] = true
+		g.Orders = len(seen[key])
+	}
+
+	for _, o := range orders {
+		if by == GroupByMonth {
+			if o.CreatedAt.IsZero() {
+				return nil, fmt.Errorf("order %s: created_at is not set", o.Id)
+			}
+			add(o.CreatedAt.Format("2006-01"), o.Id, OrderProfit(o).Breakdown)
+			continue
+		}
+
+		lines := orderLines(o)
+		for i, li := range o.LineItems {
+			var key string
+			switch by {
+			case GroupByProduct:
+				key = li.ProductId
+			case GroupBySku:
+				key = li.Metadata.Sku
+			case GroupByPrintProvider:
+				key = strconv.Itoa(li.PrintProviderId)
+			}
+			add(key, o.Id, lines[i])
+		}
+	}
+
+	result := make([]Group, 0, len(groups))
+	for _, g := range groups {
+		g.finish()
+		result = append(result, *g)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result, nil
+}
+
+// Total sums the profitability of every order.
+func Total(orders []order.Order) Breakdown {
+	var total Breakdown
+	for _, o := range orders {
+		total.add(OrderProfit(o).Breakdown)
+	}
+	total.finish()
+	return total
+}
+
+// orderLines returns the LineProfit of each line item of o with the order's shipping
+// cost allocated to the lines in proportion to their own shipping cost, or to their
+// quantity when no line carries one. The last line takes the rounding remainder.
+func orderLines(o order.Order) []Breakdown {
+	lines := make([]Breakdown, 0, len(o.LineItems))
+	lineShipping, quantity := 0, 0
+	for _, li := range o.LineItems {
+		line := LineProfit(li)
+		lineShipping += line.ShippingCost
+		quantity += line.Quantity
+		lines = append(lines, line)
+	}
+	total := o.TotalShipping.Cents()
+	if o.TotalShipping.IsZero() || total == lineShipping || len(lines) == 0 {
+		return lines
+	}
+
+	allocated := 0
+	for i := range lines {
+		share := 0
+		switch {
+		case i == len(lines)-1:
+			share = total - allocated
+		case lineShipping != 0:
+			share = total * lines[i].ShippingCost / lineShipping
+		case quantity != 0:
+			share = total * lines[i].Quantity / quantity
+		}
+		allocated += share
+		lines[i].ShippingCost = share
+		lines[i].finish()
+	}
+	return lines
+}
+
+func (b *Breakdown) add(other Breakdown) {
+	b.Revenue += other.Revenue
+	b.ProductionCost += other.ProductionCost
+	b.ShippingCost += other.ShippingCost
+	b.Tax += other.Tax
+	b.Quantity += other.Quantity
+	b.Orders += other.Orders
+}
+
+func (b *Breakdown) finish() {
+	b.GrossMargin = b.Revenue - b.ProductionCost - b.ShippingCost
+	b.MarginPercent = 0
+	if b.Revenue != 0 {
+		b.MarginPercent = float64(b.GrossMargin) / float64(b.Revenue) * 100
+	}
+}
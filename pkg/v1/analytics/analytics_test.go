@@ -0,0 +1,104 @@
+package analytics
+
+import (
+	"fmt"
+
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+func timestamp(value string) common.Timestamp {
+	t, _ := common.ParseTimestamp(value)
+	return t
+}
+
+func cents(amount int) common.Money {
+	return common.NewMoney(amount, "")
+}
+
+var exampleOrders = []order.Order{
+	{
+		Id:            "ord_1",
+		CreatedAt:     timestamp("2024-03-02 10:00:00+00:00"),
+		TotalShipping: cents(599),
+		TotalTax:      cents(250),
+		LineItems: []order.LineItem{
+			{ProductId: "prod_1", Quantity: 1, PrintProviderId: 5, Cost: cents(1050), ShippingCost: cents(400), Metadata: order.LineItemMetadata{Sku: "TEE-BLK-M", Price: cents(2500)}},
+			{ProductId: "prod_2", Quantity: 2, PrintProviderId: 29, Cost: cents(1500), ShippingCost: cents(199), Metadata: order.LineItemMetadata{Sku: "MUG-11", Price: cents(1000)}},
+		},
+	},
+	{
+		Id:        "ord_2",
+		CreatedAt: timestamp("2024-04-11 08:00:00+00:00"),
+		LineItems: []order.LineItem{
+			{ProductId: "prod_1", Quantity: 3, PrintProviderId: 5, Cost: cents(3150), ShippingCost: cents(800), Metadata: order.LineItemMetadata{Sku: "TEE-BLK-M", Price: cents(2500)}},
+		},
+	},
+}
+
+func ExampleLineProfit() {
+	b := LineProfit(exampleOrders[0].LineItems[1])
+	fmt.Printf("revenue=%d cost=%d shipping=%d margin=%d (%.1f%%)\n", b.Revenue, b.ProductionCost, b.ShippingCost, b.GrossMargin, b.MarginPercent)
+	// Output: revenue=2000 cost=1500 shipping=199 margin=301 (15.0%)
+}
+
+func ExampleOrderProfit() {
+	ob := OrderProfit(exampleOrders[0])
+	fmt.Printf("%s revenue=%d cost=%d shipping=%d tax=%d margin=%d lines=%d\n", ob.OrderId, ob.Revenue, ob.ProductionCost, ob.ShippingCost, ob.Tax, ob.GrossMargin, len(ob.Lines))
+	// Output: ord_1 revenue=4500 cost=2550 shipping=599 tax=250 margin=1351 lines=2
+}
+
+func ExampleAggregate() {
+	groups, _ := Aggregate(exampleOrders, GroupBySku)
+	for _, g := range groups {
+		fmt.Printf("%s orders=%d qty=%d margin=%d (%.1f%%)\n", g.Key, g.Orders, g.Quantity, g.GrossMargin, g.MarginPercent)
+	}
+	// Output:
+	// MUG-11 orders=1 qty=2 margin=301 (15.0%)
+	// TEE-BLK-M orders=2 qty=4 margin=4600 (46.0%)
+}
+
+func ExampleAggregate_month() {
+	groups, _ := Aggregate(exampleOrders, GroupByMonth)
+	for _, g := range groups {
+		fmt.Printf("%s revenue=%d margin=%d\n", g.Key, g.Revenue, g.GrossMargin)
+	}
+	// Output:
+	// 2024-03 revenue=4500 margin=1351
+	// 2024-04 revenue=7500 margin=3550
+}
+
+func ExampleTotal() {
+	b := Total(exampleOrders)
+	fmt.Printf("orders=%d revenue=%d margin=%d (%.1f%%)\n", b.Orders, b.Revenue, b.GrossMargin, b.MarginPercent)
+	// Output: orders=2 revenue=12000 margin=4901 (40.8%)
+}
+
+func ExampleAggregate_shipping() {
+	// TotalShipping differs from the line shipping costs; it is allocated to the lines
+	// so product groups add up to the order.
+	orders := []order.Order{{
+		Id:            "ord_3",
+		CreatedAt:     timestamp("2024-05-01 08:00:00+00:00"),
+		TotalShipping: cents(900),
+		LineItems: []order.LineItem{
+			{ProductId: "prod_1", Quantity: 1, Cost: cents(1000), ShippingCost: cents(400), Metadata: order.LineItemMetadata{Price: cents(2500)}},
+			{ProductId: "prod_2", Quantity: 1, Cost: cents(500), ShippingCost: cents(200), Metadata: order.LineItemMetadata{Price: cents(1500)}},
+		},
+	}}
+	groups, _ := Aggregate(orders, GroupByProduct)
+	sum := 0
+	for _, g := range groups {
+		fmt.Printf("%s shipping=%d margin=%d\n", g.Key, g.ShippingCost, g.GrossMargin)
+		sum += g.GrossMargin
+	}
+	fmt.Println(sum == Total(orders).GrossMargin)
+
+	_, err := Aggregate(nil, "week")
+	fmt.Println(err)
+	// Output:
+	// prod_1 shipping=600 margin=900
+	// prod_2 shipping=300 margin=700
+	// true
+	// unsupported grouping: week
+}
@@ -0,0 +1,47 @@
+package analytics
+
+// Breakdown holds revenue, cost and margin figures in cents.
+//
+// GrossMargin is Revenue minus ProductionCost and ShippingCost. Tax is reported
+// separately and is not deducted, since it is passed through to the buyer.
+type Breakdown struct {
+	Revenue        int
+	ProductionCost int
+	ShippingCost   int
+	Tax            int
+	GrossMargin    int
+	// MarginPercent is GrossMargin as a percentage of Revenue, or 0 when there is no revenue.
+	MarginPercent float64
+	// Quantity is the number of units sold.
+	Quantity int
+	// Orders is the number of distinct orders that contributed to the figures.
+	Orders int
+}
+
+// OrderBreakdown is the profitability of a single order and each of its line items.
+type OrderBreakdown struct {
+	OrderId string
+	Breakdown
+	// Lines holds one entry per order line item, in the same order.
+	Lines []Breakdown
+}
+
+// GroupByEnum selects how Aggregate buckets orders.
+type GroupByEnum string
+
+const (
+	// GroupByProduct groups line items by Printify product id.
+	GroupByProduct GroupByEnum = "product"
+	// GroupBySku groups line items by variant SKU.
+	GroupBySku GroupByEnum = "sku"
+	// GroupByPrintProvider groups line items by print provider id.
+	GroupByPrintProvider GroupByEnum = "print_provider"
+	// GroupByMonth groups whole orders by the month they were created, formatted as YYYY-MM.
+	GroupByMonth GroupByEnum = "month"
+)
+
+// Group is an aggregated Breakdown for one key.
+type Group struct {
+	Key string
+	Breakdown
+}
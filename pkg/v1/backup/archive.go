@@ -0,0 +1,96 @@
+package backup
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+)
+
+type archiveWriter interface {
+	WriteFile(name string, data []byte) error
+}
+
+type archiveReader interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+type dirArchive string
+
+func (d dirArchive) WriteFile(name string, data []byte) error {
+	p, err := d.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(p, data, 0o644)
+}
+
+func (d dirArchive) ReadFile(name string) ([]byte, error) {
+	p, err := d.path(name)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(p)
+}
+
+// path resolves name inside the archive directory. Names come from the manifest,
+// which may be untrusted, so absolute names and names escaping the directory are rejected.
+func (d dirArchive) path(name string) (string, error) {
+	local := filepath.FromSlash(name)
+	if !filepath.IsLocal(local) {
+		return "", fmt.Errorf("%s: path is outside the archive", name)
+	}
+	return filepath.Join(string(d), local), nil
+}
+
+type tarWriter struct {
+	tw      *tar.Writer
+	modTime time.Time
+}
+
+func (t tarWriter) WriteFile(name string, data []byte) error {
+	hdr := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), ModTime: t.modTime, Typeflag: tar.TypeReg}
+	if err := t.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := t.tw.Write(data)
+	return err
+}
+
+type tarArchive map[string][]byte
+
+func readTar(r io.Reader) (tarArchive, error) {
+	files := tarArchive{}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[path.Clean(hdr.Name)] = data
+	}
+}
+
+func (t tarArchive) ReadFile(name string) ([]byte, error) {
+	data, ok := t[path.Clean(name)]
+	if !ok {
+		return nil, fmt.Errorf("%s: not found in archive", name)
+	}
+	return data, nil
+}
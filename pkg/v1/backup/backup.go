@@ -0,0 +1,267 @@
+package backup
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+	"github.com/connellrobert/printify-go/pkg/v1/uploads"
+)
+
+// Backup exports every product of a shop to an archive.
+type Backup struct {
+	Products product.Client
+	// Uploads optionally looks up uploaded image file names.
+	Uploads uploads.Client
+	ShopId  int
+	// DownloadImages stores a copy of every referenced image in the archive.
+	DownloadImages bool
+	// HTTPClient downloads images. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// NewBackup creates a Backup that references images without downloading them.
+func NewBackup(products product.Client, uploadsClient uploads.Client, shopId int) *Backup {
+	return &Backup{Products: products, Uploads: uploadsClient, ShopId: shopId}
+}
+
+// WriteDir writes the archive to a directory, creating it when needed.
+func (b *Backup) WriteDir(dir string) (*Manifest, error) {
+	return b.write(dirArchive(dir))
+}
+
+// WriteTar writes the archive as a tar stream.
+func (b *Backup) WriteTar(w io.Writer) (*Manifest, error) {
+	tw := tar.NewWriter(w)
+	m, err := b.write(tarWriter{tw: tw, modTime: b.now()})
+	if err != nil {
+		return nil, err
+	}
+	return m, tw.Close()
+}
+
+func (b *Backup) write(a archiveWriter) (*Manifest, error) {
+	products, err := b.Products.QueryProducts(b.ShopId, product.ListProductsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{Version: FormatVersion, ShopId: b.ShopId, CreatedAt: b.now().UTC(), Products: []string{}, Images: []ImageEntry{}}
+
+	seen := map[string]bool{}
+	for _, p := range products {
+		data, err := json.MarshalIndent(p, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		if err := a.WriteFile(productFile(p.Id), data); err != nil {
+			return nil, err
+		}
+		m.Products = append(m.Products, p.Id)
+
+		for _, img := range images(p) {
+			if img.Id == "" || seen[img.Id] {
+				continue
+			}
+			seen[img.Id] = true
+			entry := ImageEntry{Id: img.Id, FileName: img.Name, Src: img.Src}
+			if b.Uploads != nil {
+				if u, err := b.Uploads.GetUploadedImage(img.Id); err == nil {
+					entry.FileName = u.FileName
+					if entry.Src == "" {
+						entry.Src = u.PreviewUrl
+					}
+				}
+			}
+			if b.DownloadImages && entry.Src != "" {
+				data, err := b.download(entry.Src)
+				if err != nil {
+					return nil, fmt.Errorf("image %s: %w", img.Id, err)
+				}
+				entry.File = "images/" + img.Id + imageExt(entry)
+				if err := a.WriteFile(entry.File, data); err != nil {
+					return nil, err
+				}
+			}
+			m.Images = append(m.Images, entry)
+		}
+	}
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return m, a.WriteFile(ManifestFile, data)
+}
+
+func (b *Backup) download(src string) ([]byte, error) {
+	client := b.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Get(src)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download %s: %s", src, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (b *Backup) now() time.Time {
+	if b.Now != nil {
+		return b.Now()
+	}
+	return time.Now()
+}
+
+// Restore recreates the products of an archive in a shop.
+//
+// Images that no longer exist are uploaded again, from the archived copy when
+// there is one and from their source URL otherwise. Any other error looking an image
+// up stops the restore, so an unavailable API does not duplicate every image. Products are then created with
+// CreateProduct using the new image ids. Products and images that fail are reported
+// in Mapping.Failed.
+type Restore struct {
+	Products product.Client
+	Uploads  uploads.Client
+	ShopId   int
+}
+
+// NewRestore creates a Restore.
+func NewRestore(products product.Client, uploadsClient uploads.Client, shopId int) *Restore {
+	return &Restore{Products: products, Uploads: uploadsClient, ShopId: shopId}
+}
+
+// FromDir restores an archive written by Backup.WriteDir.
+func (r *Restore) FromDir(dir string) (*Mapping, error) {
+	return r.restore(dirArchive(dir))
+}
+
+// FromTar restores an archive written by Backup.WriteTar.
+func (r *Restore) FromTar(rd io.Reader) (*Mapping, error) {
+	a, err := readTar(rd)
+	if err != nil {
+		return nil, err
+	}
+	return r.restore(a)
+}
+
+// restore fails only when the archive cannot be read or an image cannot be looked up.
+func (r *Restore) restore(a archiveReader) (*Mapping, error) {
+	data, err := a.ReadFile(ManifestFile)
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("%s: %w", ManifestFile, err)
+	}
+	if m.Version != FormatVersion {
+		return nil, fmt.Errorf("unsupported archive version %d", m.Version)
+	}
+
+	mapping := &Mapping{Products: map[string]string{}, Images: map[string]string{}, Failed: []Failure{}}
+	for _, entry := range m.Images {
+		_, err := r.Uploads.GetUploadedImage(entry.Id)
+		if err == nil {
+			mapping.Images[entry.Id] = entry.Id
+			continue
+		}
+		if !common.IsNotFound(err) {
+			return nil, fmt.Errorf("image %s: %w", entry.Id, err)
+		}
+		id, err := r.upload(a, entry)
+		if err != nil {
+			mapping.Failed = append(mapping.Failed, Failure{Id: entry.Id, Err: err.Error()})
+			continue
+		}
+		mapping.Images[entry.Id] = id
+	}
+
+	for _, productId := range m.Products {
+		data, err := a.ReadFile(productFile(productId))
+		if err != nil {
+			return nil, err
+		}
+		var p product.Product
+		if err := json.Unmarshal(data, &p); err != nil {
+			return nil, fmt.Errorf("%s: %w", productFile(productId), err)
+		}
+		created, err := r.Products.CreateProduct(r.ShopId, creatable(p, mapping.Images))
+		if err != nil {
+			mapping.Failed = append(mapping.Failed, Failure{Id: productId, Err: err.Error()})
+			continue
+		}
+		mapping.Products[productId] = created.Id
+	}
+	return mapping, nil
+}
+
+func (r *Restore) upload(a archiveReader, entry ImageEntry) (string, error) {
+	upload := uploads.ImageUpload{Filename: entry.FileName, Url: entry.Src}
+	if entry.File != "" {
+		data, err := a.ReadFile(entry.File)
+		if err != nil {
+			return "", err
+		}
+		upload.Contents, upload.Url = data, ""
+	}
+	if upload.Filename == "" {
+		upload.Filename = entry.Id + path.Ext(entry.File)
+	}
+	uploaded, err := r.Uploads.UploadImage(upload)
+	if err != nil {
+		return "", err
+	}
+	return uploaded.Id, nil
+}
+
+// creatable returns the fields of p accepted by CreateProduct, with image ids replaced.
+func creatable(p product.Product, imageIds map[string]string) product.Product {
+	out := p.Creatable()
+	for i := range out.PrintAreas {
+		for j := range out.PrintAreas[i].Placeholders {
+			for k, img := range out.PrintAreas[i].Placeholders[j].Images {
+				if id, ok := imageIds[img.Id]; ok {
+					out.PrintAreas[i].Placeholders[j].Images[k].Id = id
+				}
+			}
+		}
+	}
+	return out
+}
+
+func images(p product.Product) []product.Image {
+	var out []product.Image
+	for _, area := range p.PrintAreas {
+		for _, ph := range area.Placeholders {
+			out = append(out, ph.Images...)
+		}
+	}
+	return out
+}
+
+func imageExt(entry ImageEntry) string {
+	if ext := path.Ext(entry.FileName); ext != "" {
+		return ext
+	}
+	if u, err := url.Parse(entry.Src); err == nil {
+		return path.Ext(u.Path)
+	}
+	return ""
+}
+
+func productFile(productId string) string {
+	return "products/" + productId + ".json"
+}
@@ -0,0 +1,117 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+	"github.com/connellrobert/printify-go/pkg/v1/uploads"
+)
+
+func newBackupTestClient(register func(mux *http.ServeMux)) (*common.Client, func()) {
+	mux := http.NewServeMux()
+	register(mux)
+	srv := httptest.NewServer(mux)
+	c := common.NewClient("printify_pat", 123)
+	c.Host = srv.URL
+	return c, srv.Close
+}
+
+func Example() {
+	var c *common.Client
+	c, closeFn := newBackupTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/products.json", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				var p product.Product
+				_ = json.NewDecoder(r.Body).Decode(&p)
+				fmt.Println("create", p.Title, p.Id == "", p.PrintAreas[0].Placeholders[0].Images[0].Id)
+				_, _ = w.Write([]byte(`{"id":"prod_new"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":[{"id":"prod_1","title":"Logo Tee","blueprint_id":6,"print_provider_id":99,
+"variants":[{"id":17887,"price":2500,"is_enabled":true}],
+"print_areas":[{"variant_ids":[17887],"placeholders":[{"position":"front","images":[{"id":"img_1","src":"` + c.Host + `/files/logo.png","x":0.5,"y":0.5,"scale":1}]}]}]}]}`))
+		})
+		mux.HandleFunc("/files/logo.png", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("PNG"))
+		})
+		mux.HandleFunc("/v1/uploads/img_1.json", func(w http.ResponseWriter, _ *http.Request) {
+			http.NotFound(w, nil)
+		})
+		mux.HandleFunc("/v1/uploads/images.json", func(w http.ResponseWriter, r *http.Request) {
+			var u uploads.ImageUpload
+			_ = json.NewDecoder(r.Body).Decode(&u)
+			fmt.Printf("upload %s %q\n", u.Filename, u.Contents)
+			_, _ = w.Write([]byte(`{"id":"img_new"}`))
+		})
+	})
+	defer closeFn()
+
+	var archive bytes.Buffer
+	b := NewBackup(product.NewClient(c), uploads.NewClient(c), 123)
+	b.DownloadImages = true
+	m, _ := b.WriteTar(&archive)
+	fmt.Println(m.Version, m.Products, m.Images[0].File)
+
+	mapping, _ := NewRestore(product.NewClient(c), uploads.NewClient(c), 123).FromTar(&archive)
+	fmt.Println(mapping.Products, mapping.Images, len(mapping.Failed))
+	// Output:
+	// 1 [prod_1] images/img_1.png
+	// upload img_1.png "PNG"
+	// create Logo Tee true img_new
+	// map[prod_1:prod_new] map[img_1:img_new] 0
+}
+
+func ExampleRestore_FromDir() {
+	c, closeFn := newBackupTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/uploads/img_1.json", func(w http.ResponseWriter, _ *http.Request) {
+			http.NotFound(w, nil)
+		})
+		mux.HandleFunc("/v1/uploads/images.json", func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Println("uploaded")
+		})
+	})
+	defer closeFn()
+
+	root, _ := os.MkdirTemp("", "backup")
+	defer os.RemoveAll(root)
+	dir := filepath.Join(root, "archive")
+	_ = os.Mkdir(dir, 0o755)
+	_ = os.WriteFile(filepath.Join(root, "secret.txt"), []byte("secret"), 0o600)
+
+	// A tampered manifest pointing outside the archive is not read.
+	manifest := `{"version":1,"products":[],"images":[{"id":"img_1","file":"../secret.txt"}]}`
+	_ = os.WriteFile(filepath.Join(dir, ManifestFile), []byte(manifest), 0o644)
+
+	mapping, _ := NewRestore(product.NewClient(c), uploads.NewClient(c), 123).FromDir(dir)
+	fmt.Println(mapping.Failed)
+	// Output: [{img_1 ../secret.txt: path is outside the archive}]
+}
+
+func ExampleRestore_FromDir_lookupFails() {
+	c, closeFn := newBackupTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/uploads/img_1.json", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+		mux.HandleFunc("/v1/uploads/images.json", func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Println("uploaded")
+		})
+	})
+	defer closeFn()
+
+	dir, _ := os.MkdirTemp("", "backup")
+	defer os.RemoveAll(dir)
+	manifest := `{"version":1,"products":[],"images":[{"id":"img_1","src":"https://example.com/logo.png"}]}`
+	_ = os.WriteFile(filepath.Join(dir, ManifestFile), []byte(manifest), 0o644)
+
+	// Only a missing image is uploaded again; an unavailable API stops the restore.
+	_, err := NewRestore(product.NewClient(c), uploads.NewClient(c), 123).FromDir(dir)
+	fmt.Println(err)
+	// Output: image img_1: error: 503 Service Unavailable
+}
@@ -0,0 +1,49 @@
+package backup
+
+import (
+	"time"
+)
+
+// FormatVersion is the archive format written by Backup.
+const FormatVersion = 1
+
+// ManifestFile is the name of the manifest in an archive.
+const ManifestFile = "manifest.json"
+
+// Manifest describes the contents of an archive.
+//
+// An archive holds the manifest, one products/{productId}.json file per product and,
+// when images were downloaded, one images/{imageId}{ext} file per image.
+type Manifest struct {
+	Version   int          `json:"version"`
+	ShopId    int          `json:"shop_id"`
+	CreatedAt time.Time    `json:"created_at"`
+	Products  []string     `json:"products"`
+	Images    []ImageEntry `json:"images"`
+}
+
+// ImageEntry is an uploaded image referenced by the products in an archive.
+type ImageEntry struct {
+	Id       string `json:"id"`
+	FileName string `json:"file_name"`
+	// Src is the image URL reported by Printify.
+	Src string `json:"src"`
+	// File is the image's path in the archive, or "" when it was not downloaded.
+	File string `json:"file,omitempty"`
+}
+
+// Mapping maps the ids in an archive to the ids created by a restore.
+type Mapping struct {
+	// Products maps old product ids to new ones.
+	Products map[string]string `json:"products"`
+	// Images maps old image ids to new ones. Images that still exist keep their id.
+	Images map[string]string `json:"images"`
+	// Failed lists the products that could not be restored.
+	Failed []Failure `json:"failed"`
+}
+
+// Failure is a product or image that could not be restored.
+type Failure struct {
+	Id  string `json:"id"`
+	Err string `json:"error"`
+}
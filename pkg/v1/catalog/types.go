@@ -1,5 +1,9 @@
 package catalog
 
+import (
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+)
+
 // Blueprint describes a catalog blueprint returned by /v1/catalog/blueprints endpoints.
 type Blueprint struct {
 	Id     int      `json:"id"`
@@ -42,9 +46,10 @@ type VariantOptions struct {
 
 // Placeholder describes a printable area placeholder for a variant.
 type Placeholder struct {
-	Position int `json:"position"`
-	Height   int `json:"height"`
-	Width    int `json:"width"`
+	// Position names the placeholder, for example "front" or "back".
+	Position string `json:"position"`
+	Height   int    `json:"height"`
+	Width    int    `json:"width"`
 }
 
 // Shipping contains shipping details for a blueprint/provider pair.
@@ -69,14 +74,24 @@ type Profile struct {
 
 // FirstItem is the first-item shipping price component.
 type FirstItem struct {
-	Currency string `json:"currency"`
-	Cost     string `json:"cost"`
+	Currency string       `json:"currency"`
+	Cost     common.Money `json:"cost"`
+}
+
+// Price returns Cost tagged with Currency.
+func (i FirstItem) Price() common.Money {
+	return common.NewMoney(i.Cost.Amount, i.Currency)
 }
 
 // AdditionalItems is the additional-items shipping price component.
 type AdditionalItems struct {
-	Currency string `json:"currency"`
-	Cost     string `json:"cost"`
+	Currency string       `json:"currency"`
+	Cost     common.Money `json:"cost"`
+}
+
+// Price returns Cost tagged with Currency.
+func (i AdditionalItems) Price() common.Money {
+	return common.NewMoney(i.Cost.Amount, i.Currency)
 }
 
 // PrintDetails represents print-side metadata returned in catalog responses.
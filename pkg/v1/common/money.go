@@ -0,0 +1,142 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Money is an amount in the smallest currency unit (cents) with an optional ISO 4217 currency code.
+//
+// Printify sends prices as bare integers in cents, so decoded values have an empty
+// Currency unless the caller sets one. Money encodes back to the bare integer.
+type Money struct {
+	Amount   int
+	Currency string
+}
+
+// NewMoney creates a Money value from an amount in cents.
+func NewMoney(amount int, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// ParseDecimal parses a decimal string such as "12.50" into Money. Digits past the
+// cents are rounded half away from zero, so "0.285" is 29 cents.
+func ParseDecimal(value string, currency string) (Money, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Money{Currency: currency}, nil
+	}
+	digits, negative := strings.CutPrefix(value, "-")
+	if !negative {
+		digits = strings.TrimPrefix(digits, "+")
+	}
+	whole, fraction, _ := strings.Cut(digits, ".")
+	if (whole == "" && fraction == "") || !isDigits(whole) || !isDigits(fraction) {
+		return Money{}, fmt.Errorf("invalid amount: %q", value)
+	}
+	cents, err := strconv.Atoi("0" + whole + (fraction + "00")[:2])
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount: %q", value)
+	}
+	if len(fraction) > 2 && fraction[2] >= '5' {
+		cents++
+	}
+	if negative {
+		cents = -cents
+	}
+	return Money{Amount: cents, Currency: currency}, nil
+}
+
+// Cents returns the amount in cents, matching the int value the field held before it was typed.
+func (m Money) Cents() int {
+	return m.Amount
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Add returns m + other. It fails when both values carry different currencies.
+func (m Money) Add(other Money) (Money, error) {
+	currency, err := m.currencyWith(other)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: currency}, nil
+}
+
+// Sub returns m - other. It fails when both values carry different currencies.
+func (m Money) Sub(other Money) (Money, error) {
+	currency, err := m.currencyWith(other)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount - other.Amount, Currency: currency}, nil
+}
+
+// Mul returns m multiplied by n, for example a unit price times a quantity.
+func (m Money) Mul(n int) Money {
+	return Money{Amount: m.Amount * n, Currency: m.Currency}
+}
+
+// Decimal formats the amount as a decimal string, for example 1250 -> "12.50".
+func (m Money) Decimal() string {
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, amount/100, amount%100)
+}
+
+// String formats the amount with its currency code, for example "12.50 USD".
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Decimal()
+	}
+	return m.Decimal() + " " + m.Currency
+}
+
+// MarshalJSON encodes the amount as an integer number of cents.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Amount)
+}
+
+// UnmarshalJSON decodes an integer number of cents. Quoted integers and null are also accepted.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(data, `"`)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		m.Amount = 0
+		return nil
+	}
+	amount, err := strconv.Atoi(string(data))
+	if err != nil {
+		return fmt.Errorf("invalid amount in cents: %s", data)
+	}
+	m.Amount = amount
+	return nil
+}
+
+func (m Money) currencyWith(other Money) (string, error) {
+	switch {
+	case m.Currency == "":
+		return other.Currency, nil
+	case other.Currency == "" || other.Currency == m.Currency:
+		return m.Currency, nil
+	}
+	return "", fmt.Errorf("currency mismatch: %s and %s", m.Currency, other.Currency)
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TimestampLayout is the format Printify uses for most date fields, for example "2024-03-02 10:00:00+00:00".
+const TimestampLayout = "2006-01-02 15:04:05-07:00"
+
+// Printify documents its dates as ISO formatted but returns them in a few shapes.
+// Fractional seconds are accepted, and kept on encoding, in every shape.
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	time.DateOnly,
+}
+
+// Timestamp is a date field decoded from Printify's string formats.
+//
+// Empty strings and null decode to the zero Timestamp, which encodes back to an empty
+// string. A decoded Timestamp encodes back in the format it was decoded from.
+type Timestamp struct {
+	time.Time
+
+	layout string
+}
+
+// NewTimestamp wraps t in a Timestamp.
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{Time: t}
+}
+
+// ParseTimestamp parses any of the date formats returned by Printify. An empty string yields the zero Timestamp.
+func ParseTimestamp(value string) (Timestamp, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Timestamp{}, nil
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return Timestamp{Time: t, layout: layout}, nil
+		}
+	}
+	return Timestamp{}, fmt.Errorf("unrecognized time format: %q", value)
+}
+
+// String formats the timestamp in the format it was parsed from, or returns an empty
+// string for the zero Timestamp. Timestamps created with NewTimestamp use TimestampLayout.
+//
+// This matches the string value the field held before it was typed.
+func (t Timestamp) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	if t.layout == "" {
+		return t.Format(TimestampLayout)
+	}
+	return t.Format(t.layout)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t Timestamp) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *Timestamp) UnmarshalText(data []byte) error {
+	parsed, err := ParseTimestamp(string(data))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = Timestamp{}
+		return nil
+	}
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(value))
+}
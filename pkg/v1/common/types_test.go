@@ -0,0 +1,74 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func ExampleParseTimestamp() {
+	t, _ := ParseTimestamp("2024-03-02T10:00:00Z")
+	fmt.Println(t)
+	fmt.Println(NewTimestamp(t.Time))
+	// Output:
+	// 2024-03-02T10:00:00Z
+	// 2024-03-02 10:00:00+00:00
+}
+
+func ExampleTimestamp_MarshalJSON() {
+	var v struct {
+		CreatedAt Timestamp `json:"created_at"`
+		UpdatedAt Timestamp `json:"updated_at"`
+	}
+	in := `{"created_at":"2024-03-02T10:00:00.123456Z","updated_at":"2024-03-02 10:00:00.5+00:00"}`
+	_ = json.Unmarshal([]byte(in), &v)
+	out, _ := json.Marshal(v)
+	fmt.Println(string(out) == in)
+	// Output: true
+}
+
+func ExampleTimestamp_UnmarshalJSON() {
+	var v struct {
+		CreatedAt   Timestamp `json:"created_at"`
+		FulfilledAt Timestamp `json:"fulfilled_at"`
+	}
+	_ = json.Unmarshal([]byte(`{"created_at":"2024-03-02 10:00:00+00:00","fulfilled_at":null}`), &v)
+	fmt.Println(v.CreatedAt.Year(), v.FulfilledAt.IsZero())
+	out, _ := json.Marshal(v)
+	fmt.Println(string(out))
+	// Output:
+	// 2024 true
+	// {"created_at":"2024-03-02 10:00:00+00:00","fulfilled_at":""}
+}
+
+func ExampleMoney_Add() {
+	price := NewMoney(2500, "USD")
+	total, _ := price.Mul(2).Add(NewMoney(599, ""))
+	fmt.Println(total)
+	_, err := price.Add(NewMoney(100, "EUR"))
+	fmt.Println(err)
+	// Output:
+	// 55.99 USD
+	// currency mismatch: USD and EUR
+}
+
+func ExampleMoney_UnmarshalJSON() {
+	var v struct {
+		Cost Money `json:"cost"`
+	}
+	_ = json.Unmarshal([]byte(`{"cost":1050}`), &v)
+	fmt.Println(v.Cost.Cents(), v.Cost.Decimal())
+	// Output: 1050 10.50
+}
+
+func ExampleParseDecimal() {
+	for _, value := range []string{"19.99", "0.285", "-1.5", "7", "1.2.3"} {
+		m, err := ParseDecimal(value, "USD")
+		fmt.Println(m.Cents(), err)
+	}
+	// Output:
+	// 1999 <nil>
+	// 29 <nil>
+	// -150 <nil>
+	// 700 <nil>
+	// 0 invalid amount: "1.2.3"
+}
@@ -0,0 +1,182 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+// Writer streams orders to CSV or JSON Lines.
+//
+// Orders are written one at a time with Write, so callers can feed pages from
+// order.ListOrdersPage without holding every order in memory. Call Flush once all
+// orders have been written.
+type Writer struct {
+	w           io.Writer
+	csv         *csv.Writer
+	opts        Options
+	wroteHeader bool
+}
+
+// NewWriter creates a Writer for the given destination and options.
+func NewWriter(w io.Writer, opts Options) (*Writer, error) {
+	if opts.Format == "" {
+		opts.Format = FormatCSV
+	}
+	if opts.Granularity == "" {
+		opts.Granularity = RowPerOrder
+	}
+	if len(opts.Columns) == 0 {
+		if opts.Granularity == RowPerLineItem {
+			opts.Columns = DefaultLineItemColumns
+		} else {
+			opts.Columns = DefaultOrderColumns
+		}
+	}
+
+	switch opts.Format {
+	case FormatCSV, FormatJSONL:
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", opts.Format)
+	}
+	switch opts.Granularity {
+	case RowPerOrder, RowPerLineItem:
+	default:
+		return nil, fmt.Errorf("unsupported granularity: %s", opts.Granularity)
+	}
+
+	ew := &Writer{w: w, opts: opts}
+	if opts.Format == FormatCSV {
+		ew.csv = csv.NewWriter(w)
+	}
+	return ew, nil
+}
+
+// Write exports a single order. Orders outside the configured date ranges are skipped.
+func (ew *Writer) Write(o order.Order) error {
+	if !ew.include(o) {
+		return nil
+	}
+
+	if ew.opts.Granularity == RowPerOrder {
+		return ew.writeRow(Row{Order: o})
+	}
+	for i := range o.LineItems {
+		if err := ew.writeRow(Row{Order: o, LineItem: &o.LineItems[i]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes any buffered data to the underlying io.Writer.
+//
+// A CSV export with no rows still receives its header.
+func (ew *Writer) Flush() error {
+	if ew.csv == nil {
+		return nil
+	}
+	if !ew.wroteHeader {
+		if err := ew.writeHeader(); err != nil {
+			return err
+		}
+	}
+	ew.csv.Flush()
+	return ew.csv.Error()
+}
+
+// WriteOrders exports every order to w and flushes the result.
+func WriteOrders(w io.Writer, opts Options, orders []order.Order) error {
+	ew, err := NewWriter(w, opts)
+	if err != nil {
+		return err
+	}
+	for _, o := range orders {
+		if err := ew.Write(o); err != nil {
+			return err
+		}
+	}
+	return ew.Flush()
+}
+
+// Cents renders an integer amount of cents as a decimal string, for example 1250 -> "12.50".
+func Cents(amount int) string {
+	return common.NewMoney(amount, "").Decimal()
+}
+
+func (ew *Writer) include(o order.Order) bool {
+	if !ew.opts.CreatedFrom.IsZero() || !ew.opts.CreatedTo.IsZero() {
+		if o.CreatedAt.IsZero() || !inRange(o.CreatedAt.Time, ew.opts.CreatedFrom, ew.opts.CreatedTo) {
+			return false
+		}
+	}
+	if !ew.opts.FulfilledFrom.IsZero() || !ew.opts.FulfilledTo.IsZero() {
+		if o.FulfilledAt.IsZero() || !inRange(o.FulfilledAt.Time, ew.opts.FulfilledFrom, ew.opts.FulfilledTo) {
+			return false
+		}
+	}
+	return true
+}
+
+func (ew *Writer) writeHeader() error {
+	ew.wroteHeader = true
+	header := make([]string, len(ew.opts.Columns))
+	for i, col := range ew.opts.Columns {
+		header[i] = col.Name
+	}
+	return ew.csv.Write(header)
+}
+
+func (ew *Writer) writeRow(r Row) error {
+	if ew.csv != nil {
+		if !ew.wroteHeader {
+			if err := ew.writeHeader(); err != nil {
+				return err
+			}
+		}
+		record := make([]string, len(ew.opts.Columns))
+		for i, col := range ew.opts.Columns {
+			record[i] = col.Value(r)
+		}
+		return ew.csv.Write(record)
+	}
+
+	// Build the object by hand so keys keep the configured column order.
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, col := range ew.opts.Columns {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(col.Name)
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(col.Value(r))
+		if err != nil {
+			return err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteString("}\n")
+	_, err := ew.w.Write(buf.Bytes())
+	return err
+}
+
+func inRange(t, from, to time.Time) bool {
+	if !from.IsZero() && t.Before(from) {
+		return false
+	}
+	if !to.IsZero() && !t.Before(to) {
+		return false
+	}
+	return true
+}
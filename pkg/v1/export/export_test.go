@@ -0,0 +1,78 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+func timestamp(value string) common.Timestamp {
+	t, _ := common.ParseTimestamp(value)
+	return t
+}
+
+func cents(amount int) common.Money {
+	return common.NewMoney(amount, "")
+}
+
+var exampleOrders = []order.Order{
+	{
+		Id:            "ord_1",
+		Status:        "fulfilled",
+		CreatedAt:     timestamp("2024-03-02 10:00:00+00:00"),
+		FulfilledAt:   timestamp("2024-03-05 16:30:00+00:00"),
+		AddressTo:     order.Address{Country: "US"},
+		Metadata:      order.OrderMetadata{ShopOrderId: 1001},
+		TotalPrice:    cents(4500),
+		TotalShipping: cents(599),
+		TotalTax:      cents(0),
+		LineItems: []order.LineItem{
+			{ProductId: "prod_1", VariantId: "17887", Quantity: 1, PrintProviderId: 5, Cost: cents(1050), ShippingCost: cents(400), Metadata: order.LineItemMetadata{Sku: "TEE-BLK-M", Price: cents(2500)}},
+			{ProductId: "prod_2", VariantId: "12100", Quantity: 2, PrintProviderId: 5, Cost: cents(1500), ShippingCost: cents(199), Metadata: order.LineItemMetadata{Sku: "MUG-11", Price: cents(1000)}},
+		},
+	},
+	{
+		Id:        "ord_2",
+		Status:    "on-hold",
+		CreatedAt: timestamp("2024-04-01 08:00:00+00:00"),
+	},
+}
+
+func ExampleWriteOrders() {
+	_ = WriteOrders(os.Stdout, Options{}, exampleOrders)
+	// Output:
+	// order_id,status,created_at,fulfilled_at,shop_order_id,country,total_price,total_shipping,total_tax,total_cost
+	// ord_1,fulfilled,2024-03-02 10:00:00+00:00,2024-03-05 16:30:00+00:00,1001,US,45.00,5.99,0.00,25.50
+	// ord_2,on-hold,2024-04-01 08:00:00+00:00,,0,,0.00,0.00,0.00,0.00
+}
+
+func ExampleWriteOrders_lineItems() {
+	columns, _ := ColumnsByName("order_id", "sku", "quantity", "line_cost")
+	_ = WriteOrders(os.Stdout, Options{Granularity: RowPerLineItem, Columns: columns}, exampleOrders)
+	// Output:
+	// order_id,sku,quantity,line_cost
+	// ord_1,TEE-BLK-M,1,10.50
+	// ord_1,MUG-11,2,15.00
+}
+
+func ExampleNewWriter() {
+	w, _ := NewWriter(os.Stdout, Options{
+		Format:      FormatJSONL,
+		Columns:     []Column{ColumnOrderId, ColumnTotalPrice},
+		CreatedFrom: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		CreatedTo:   time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+	})
+	for _, o := range exampleOrders {
+		_ = w.Write(o)
+	}
+	_ = w.Flush()
+	// Output: {"order_id":"ord_1","total_price":"45.00"}
+}
+
+func ExampleCents() {
+	fmt.Println(Cents(1250), Cents(5), Cents(-199))
+	// Output: 12.50 0.05 -1.99
+}
@@ -0,0 +1,154 @@
+package export
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+// FormatEnum selects the encoding used by a Writer.
+type FormatEnum string
+
+const (
+	// FormatCSV writes a header row followed by one comma separated record per row.
+	FormatCSV FormatEnum = "csv"
+	// FormatJSONL writes one JSON object per line, keyed by column name.
+	FormatJSONL FormatEnum = "jsonl"
+)
+
+// GranularityEnum selects whether a Writer emits one row per order or one row per line item.
+type GranularityEnum string
+
+const (
+	// RowPerOrder emits a single row for every order.
+	RowPerOrder GranularityEnum = "order"
+	// RowPerLineItem emits a row for every line item, repeating the order level columns.
+	RowPerLineItem GranularityEnum = "line_item"
+)
+
+// Row is the value a Column extracts its cell from.
+//
+// LineItem is nil when the Writer is configured with RowPerOrder.
+type Row struct {
+	Order    order.Order
+	LineItem *order.LineItem
+}
+
+// Column describes a single exported column.
+type Column struct {
+	// Name is used as the CSV header and as the JSONL object key.
+	Name string
+	// Value renders the cell for a row.
+	Value func(r Row) string
+}
+
+// Options configures a Writer.
+type Options struct {
+	// Format defaults to FormatCSV.
+	Format FormatEnum
+	// Granularity defaults to RowPerOrder.
+	Granularity GranularityEnum
+	// Columns defaults to DefaultOrderColumns or DefaultLineItemColumns depending on Granularity.
+	Columns []Column
+	// CreatedFrom and CreatedTo restrict exported orders to those created in [CreatedFrom, CreatedTo).
+	// A zero value leaves that side of the range open.
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// FulfilledFrom and FulfilledTo restrict exported orders to those fulfilled in [FulfilledFrom, FulfilledTo).
+	// When either bound is set, orders that have not been fulfilled are skipped.
+	FulfilledFrom time.Time
+	FulfilledTo   time.Time
+}
+
+// Built-in columns. Line item columns render an empty cell when used with RowPerOrder.
+var (
+	ColumnOrderId            = Column{Name: "order_id", Value: func(r Row) string { return r.Order.Id }}
+	ColumnStatus             = Column{Name: "status", Value: func(r Row) string { return r.Order.Status }}
+	ColumnCreatedAt          = Column{Name: "created_at", Value: func(r Row) string { return r.Order.CreatedAt.String() }}
+	ColumnSentToProductionAt = Column{Name: "sent_to_production_at", Value: func(r Row) string { return r.Order.SentToProductionAt.String() }}
+	ColumnFulfilledAt        = Column{Name: "fulfilled_at", Value: func(r Row) string { return r.Order.FulfilledAt.String() }}
+	ColumnOrderType          = Column{Name: "order_type", Value: func(r Row) string { return r.Order.Metadata.OrderType }}
+	ColumnShopOrderId        = Column{Name: "shop_order_id", Value: func(r Row) string { return strconv.Itoa(r.Order.Metadata.ShopOrderId) }}
+	ColumnShopOrderLabel     = Column{Name: "shop_order_label", Value: func(r Row) string { return r.Order.Metadata.ShopOrderLabel }}
+	ColumnCountry            = Column{Name: "country", Value: func(r Row) string { return r.Order.AddressTo.Country }}
+	ColumnTotalPrice         = Column{Name: "total_price", Value: func(r Row) string { return r.Order.TotalPrice.Decimal() }}
+	ColumnTotalShipping      = Column{Name: "total_shipping", Value: func(r Row) string { return r.Order.TotalShipping.Decimal() }}
+	ColumnTotalTax           = Column{Name: "total_tax", Value: func(r Row) string { return r.Order.TotalTax.Decimal() }}
+	ColumnTotalCost          = Column{Name: "total_cost", Value: func(r Row) string { return Cents(totalCost(r.Order)) }}
+	ColumnLineItemCount      = Column{Name: "line_item_count", Value: func(r Row) string { return strconv.Itoa(len(r.Order.LineItems)) }}
+
+	ColumnProductId              = Column{Name: "product_id", Value: lineValue(func(li order.LineItem) string { return li.ProductId })}
+	ColumnVariantId              = Column{Name: "variant_id", Value: lineValue(func(li order.LineItem) string { return li.VariantId })}
+	ColumnSku                    = Column{Name: "sku", Value: lineValue(func(li order.LineItem) string { return li.Metadata.Sku })}
+	ColumnTitle                  = Column{Name: "title", Value: lineValue(func(li order.LineItem) string { return li.Metadata.Title })}
+	ColumnVariantLabel           = Column{Name: "variant_label", Value: lineValue(func(li order.LineItem) string { return li.Metadata.VariantLabel })}
+	ColumnQuantity               = Column{Name: "quantity", Value: lineValue(func(li order.LineItem) string { return strconv.Itoa(li.Quantity) })}
+	ColumnPrintProviderId        = Column{Name: "print_provider_id", Value: lineValue(func(li order.LineItem) string { return strconv.Itoa(li.PrintProviderId) })}
+	ColumnLineStatus             = Column{Name: "line_status", Value: lineValue(func(li order.LineItem) string { return li.Status })}
+	ColumnLinePrice              = Column{Name: "line_price", Value: lineValue(func(li order.LineItem) string { return li.Metadata.Price.Decimal() })}
+	ColumnLineCost               = Column{Name: "line_cost", Value: lineValue(func(li order.LineItem) string { return li.Cost.Decimal() })}
+	ColumnLineShippingCost       = Column{Name: "line_shipping_cost", Value: lineValue(func(li order.LineItem) string { return li.ShippingCost.Decimal() })}
+	ColumnLineFulfilledAt        = Column{Name: "line_fulfilled_at", Value: lineValue(func(li order.LineItem) string { return li.FulfilledAt.String() })}
+	ColumnProviderCountry        = Column{Name: "provider_country", Value: lineValue(func(li order.LineItem) string { return li.Metadata.Country })}
+	ColumnLineSentToProductionAt = Column{Name: "line_sent_to_production_at", Value: lineValue(func(li order.LineItem) string { return li.SentToProductionAt.String() })}
+)
+
+var (
+	// DefaultOrderColumns is used for RowPerOrder exports when Options.Columns is empty.
+	DefaultOrderColumns = []Column{
+		ColumnOrderId, ColumnStatus, ColumnCreatedAt, ColumnFulfilledAt, ColumnShopOrderId, ColumnCountry,
+		ColumnTotalPrice, ColumnTotalShipping, ColumnTotalTax, ColumnTotalCost,
+	}
+	// DefaultLineItemColumns is used for RowPerLineItem exports when Options.Columns is empty.
+	DefaultLineItemColumns = []Column{
+		ColumnOrderId, ColumnCreatedAt, ColumnFulfilledAt, ColumnProductId, ColumnVariantId, ColumnSku,
+		ColumnQuantity, ColumnPrintProviderId, ColumnLinePrice, ColumnLineCost, ColumnLineShippingCost, ColumnLineFulfilledAt,
+	}
+)
+
+var allColumns = []Column{
+	ColumnOrderId, ColumnStatus, ColumnCreatedAt, ColumnSentToProductionAt, ColumnFulfilledAt, ColumnOrderType,
+	ColumnShopOrderId, ColumnShopOrderLabel, ColumnCountry, ColumnTotalPrice, ColumnTotalShipping, ColumnTotalTax,
+	ColumnTotalCost, ColumnLineItemCount, ColumnProductId, ColumnVariantId, ColumnSku, ColumnTitle,
+	ColumnVariantLabel, ColumnQuantity, ColumnPrintProviderId, ColumnLineStatus, ColumnLinePrice, ColumnLineCost,
+	ColumnLineShippingCost, ColumnLineFulfilledAt, ColumnProviderCountry, ColumnLineSentToProductionAt,
+}
+
+// ColumnsByName looks up built-in columns by their Name, preserving the requested order.
+func ColumnsByName(names ...string) ([]Column, error) {
+	columns := make([]Column, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, col := range allColumns {
+			if col.Name == name {
+				columns = append(columns, col)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown column: %s", name)
+		}
+	}
+	return columns, nil
+}
+
+// lineValue adapts a line item accessor to a Column value, rendering an empty cell for order rows.
+func lineValue(fn func(li order.LineItem) string) func(r Row) string {
+	return func(r Row) string {
+		if r.LineItem == nil {
+			return ""
+		}
+		return fn(*r.LineItem)
+	}
+}
+
+func totalCost(o order.Order) int {
+	total := 0
+	for _, li := range o.LineItems {
+		total += li.Cost.Cents()
+	}
+	return total
+}
@@ -0,0 +1,175 @@
+package express
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+)
+
+const (
+	standardShipping = 1
+	expressShipping  = 3
+)
+
+// DefaultCountries lists the destinations Printify Express ships to.
+var DefaultCountries = []string{"US"}
+
+// Checker decides whether order submissions can ship with Printify Express.
+type Checker struct {
+	Products product.Client
+	ShopId   int
+	// Countries lists the eligible destination country codes. Defaults to DefaultCountries.
+	Countries []string
+}
+
+// NewChecker creates a Checker using DefaultCountries.
+func NewChecker(products product.Client, shopId int) *Checker {
+	return &Checker{Products: products, ShopId: shopId, Countries: DefaultCountries}
+}
+
+// Check decides whether s can be submitted with SubmitPrintifyExpressOrder.
+//
+// A line item is eligible when its product is eligible for and has enabled Printify
+// Express and its variant is eligible. Items ordered by SKU alone are resolved by
+// listing the shop's products; items that create a product on the fly are never
+// eligible. The error is only set when a product cannot be fetched.
+func (c *Checker) Check(s order.OrderSubmission) (*Result, error) {
+	result := &Result{Blockers: []Blocker{}}
+	if !c.eligibleCountry(s.AddressTo.Country) {
+		result.Destination = fmt.Sprintf("Printify Express does not ship to %q", s.AddressTo.Country)
+	}
+
+	products := map[string]*product.Product{}
+	var bySku map[string]*product.Product
+	for i, li := range s.LineItems {
+		b := Blocker{Index: i, ProductId: li.ProductId, VariantId: li.VariantId, Sku: li.Sku}
+
+		var p *product.Product
+		switch {
+		case li.ProductId != "":
+			if p = products[li.ProductId]; p == nil {
+				var err error
+				if p, err = c.Products.GetProduct(c.ShopId, li.ProductId); err != nil {
+					return nil, err
+				}
+				products[li.ProductId] = p
+			}
+		case li.Sku != "":
+			if bySku == nil {
+				var err error
+				if bySku, err = c.productsBySku(); err != nil {
+					return nil, err
+				}
+			}
+			if p = bySku[li.Sku]; p == nil {
+				b.Reason = "SKU not found in shop"
+				result.Blockers = append(result.Blockers, b)
+				continue
+			}
+			for _, v := range p.Variants {
+				if v.Sku == li.Sku {
+					b.VariantId = v.Id
+				}
+			}
+		default:
+			b.Reason = "products created from the order are not eligible"
+			result.Blockers = append(result.Blockers, b)
+			continue
+		}
+
+		if reason := variantReason(p, b.VariantId); reason != "" {
+			b.Reason = reason
+			result.Blockers = append(result.Blockers, b)
+		}
+	}
+
+	result.Eligible = result.Destination == "" && len(result.Blockers) == 0
+	return result, nil
+}
+
+// Split divides s into an express order of the eligible line items and a standard
+// order of the rest, based on a Result from Check.
+//
+// Either part is nil when it would have no line items. When the destination is not
+// eligible every item goes to the standard part. When both parts are returned their
+// external ids get "-express" and "-standard" suffixes so they remain unique.
+func Split(s order.OrderSubmission, r Result) (express, standard *order.OrderSubmission) {
+	var expressItems, standardItems []order.OrderSubmissionLineItem
+	for i, li := range s.LineItems {
+		if r.Destination == "" && !r.Blocked(i) {
+			expressItems = append(expressItems, li)
+		} else {
+			standardItems = append(standardItems, li)
+		}
+	}
+
+	if len(expressItems) > 0 {
+		e := s
+		e.LineItems = expressItems
+		e.ShippingMethod = expressShipping
+		express = &e
+	}
+	if len(standardItems) > 0 {
+		st := s
+		st.LineItems = standardItems
+		if st.ShippingMethod == expressShipping || st.ShippingMethod == 0 {
+			st.ShippingMethod = standardShipping
+		}
+		standard = &st
+	}
+	if express != nil && standard != nil && s.ExternalId != "" {
+		express.ExternalId = s.ExternalId + "-express"
+		standard.ExternalId = s.ExternalId + "-standard"
+	}
+	return express, standard
+}
+
+func (c *Checker) eligibleCountry(country string) bool {
+	countries := c.Countries
+	if countries == nil {
+		countries = DefaultCountries
+	}
+	for _, code := range countries {
+		if strings.EqualFold(code, strings.TrimSpace(country)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Checker) productsBySku() (map[string]*product.Product, error) {
+	list, err := c.Products.QueryProducts(c.ShopId, product.ListProductsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	bySku := map[string]*product.Product{}
+	for i := range list {
+		for _, v := range list[i].Variants {
+			if v.Sku != "" {
+				bySku[v.Sku] = &list[i]
+			}
+		}
+	}
+	return bySku, nil
+}
+
+func variantReason(p *product.Product, variantId int) string {
+	switch {
+	case !p.IsPrintifyExpressEligible:
+		return "product is not eligible for Printify Express"
+	case !p.IsPrintifyExpressEnabled:
+		return "Printify Express is not enabled for product"
+	}
+	for _, v := range p.Variants {
+		if v.Id != variantId {
+			continue
+		}
+		if !v.IsPrintifyExpressEligible {
+			return "variant is not eligible for Printify Express"
+		}
+		return ""
+	}
+	return "variant not found in product"
+}
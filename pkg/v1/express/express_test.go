@@ -0,0 +1,95 @@
+package express
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+)
+
+func newExpressTestClient(register func(mux *http.ServeMux)) (*common.Client, func()) {
+	mux := http.NewServeMux()
+	register(mux)
+	srv := httptest.NewServer(mux)
+	c := common.NewClient("printify_pat", 123)
+	c.Host = srv.URL
+	return c, srv.Close
+}
+
+func registerProducts(mux *http.ServeMux) {
+	tee := `{"id":"prod_1","is_printify_express_eligible":true,"is_printify_express_enabled":true,"variants":[
+{"id":17887,"sku":"TEE-BLK-M","is_printify_express_eligible":true},
+{"id":17888,"sku":"TEE-BLK-3XL","is_printify_express_eligible":false}]}`
+	mug := `{"id":"prod_2","is_printify_express_eligible":false,"variants":[{"id":12100,"sku":"MUG-11"}]}`
+	mux.HandleFunc("/v1/shops/123/products/prod_1.json", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(tee))
+	})
+	mux.HandleFunc("/v1/shops/123/products/prod_2.json", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(mug))
+	})
+	// The listing spans two pages, so SKUs on page 2 are only found by walking every page.
+	mux.HandleFunc("/v1/shops/123/products.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`{"current_page":2,"last_page":2,"data":[` + tee + `]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"current_page":1,"last_page":2,"data":[` + mug + `]}`))
+	})
+}
+
+func ExampleChecker_Check() {
+	c, closeFn := newExpressTestClient(registerProducts)
+	defer closeFn()
+
+	checker := NewChecker(product.NewClient(c), 123)
+	result, _ := checker.Check(order.OrderSubmission{
+		ExternalId: "EXT-1",
+		AddressTo:  order.Address{Country: "US"},
+		LineItems: []order.OrderSubmissionLineItem{
+			{ProductId: "prod_1", VariantId: 17887, Quantity: 1},
+			{Sku: "TEE-BLK-3XL", Quantity: 1},
+			{ProductId: "prod_2", VariantId: 12100, Quantity: 2},
+		},
+	})
+	fmt.Println("eligible:", result.Eligible)
+	for _, reason := range result.Reasons() {
+		fmt.Println(reason)
+	}
+	// Output:
+	// eligible: false
+	// line 2 (TEE-BLK-3XL): variant is not eligible for Printify Express
+	// line 3 (prod_2/12100): product is not eligible for Printify Express
+}
+
+func ExampleSplit() {
+	c, closeFn := newExpressTestClient(registerProducts)
+	defer closeFn()
+
+	s := order.OrderSubmission{
+		ExternalId:     "EXT-1",
+		ShippingMethod: 3,
+		AddressTo:      order.Address{Country: "US"},
+		LineItems: []order.OrderSubmissionLineItem{
+			{ProductId: "prod_1", VariantId: 17887, Quantity: 1},
+			{ProductId: "prod_2", VariantId: 12100, Quantity: 2},
+		},
+	}
+	result, _ := NewChecker(product.NewClient(c), 123).Check(s)
+	express, standard := Split(s, *result)
+	fmt.Println(express.ExternalId, express.ShippingMethod, express.LineItems[0].ProductId)
+	fmt.Println(standard.ExternalId, standard.ShippingMethod, standard.LineItems[0].ProductId)
+
+	s.AddressTo.Country = "CA"
+	result, _ = NewChecker(product.NewClient(c), 123).Check(s)
+	express, standard = Split(s, *result)
+	fmt.Println(result.Destination)
+	fmt.Println(express == nil, standard.ExternalId, len(standard.LineItems))
+	// Output:
+	// EXT-1-express 3 prod_1
+	// EXT-1-standard 1 prod_2
+	// Printify Express does not ship to "CA"
+	// true EXT-1 2
+}
@@ -0,0 +1,55 @@
+package express
+
+import (
+	"fmt"
+)
+
+// Blocker is a line item that prevents an order from shipping with Printify Express.
+type Blocker struct {
+	// Index is the position of the line item in OrderSubmission.LineItems.
+	Index     int    `json:"index"`
+	ProductId string `json:"product_id,omitempty"`
+	VariantId int    `json:"variant_id,omitempty"`
+	Sku       string `json:"sku,omitempty"`
+	Reason    string `json:"reason"`
+}
+
+func (b Blocker) String() string {
+	item := b.Sku
+	if b.ProductId != "" {
+		item = fmt.Sprintf("%s/%d", b.ProductId, b.VariantId)
+	}
+	return fmt.Sprintf("line %d (%s): %s", b.Index+1, item, b.Reason)
+}
+
+// Result is the Printify Express eligibility of an order submission.
+type Result struct {
+	// Eligible is true when the destination and every line item are eligible.
+	Eligible bool `json:"eligible"`
+	// Destination explains why the destination is not eligible. It is empty when it is.
+	Destination string `json:"destination,omitempty"`
+	// Blockers lists the line items that are not eligible.
+	Blockers []Blocker `json:"blockers"`
+}
+
+// Blocked reports whether the line item at index is not eligible.
+func (r Result) Blocked(index int) bool {
+	for _, b := range r.Blockers {
+		if b.Index == index {
+			return true
+		}
+	}
+	return false
+}
+
+// Reasons lists every reason the order is not eligible.
+func (r Result) Reasons() []string {
+	var reasons []string
+	if r.Destination != "" {
+		reasons = append(reasons, r.Destination)
+	}
+	for _, b := range r.Blockers {
+		reasons = append(reasons, b.String())
+	}
+	return reasons
+}
@@ -0,0 +1,200 @@
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+// DefaultWindow is how long a submission is remembered when Submitter.Window is zero.
+const DefaultWindow = 24 * time.Hour
+
+// Key returns a stable hash identifying a submission.
+//
+// It covers the external id, the recipient, the shipping method and the line items,
+// including the artwork of products created on the fly. Addresses are compared
+// ignoring case and repeated whitespace, and line items ignoring their order, so a
+// retried checkout produces the same key.
+func Key(s order.OrderSubmission) string {
+	a := s.AddressTo
+	parts := []string{
+		"external_id=" + strings.TrimSpace(s.ExternalId),
+		"first_name=" + normalize(a.FirstName),
+		"last_name=" + normalize(a.LastName),
+		"company=" + normalize(a.Company),
+		"address1=" + normalize(a.Address1),
+		"address2=" + normalize(a.Address2),
+		"city=" + normalize(a.City),
+		"region=" + normalize(a.Region),
+		"zip=" + strings.ReplaceAll(normalize(a.Zip), " ", ""),
+		"country=" + normalize(a.Country),
+		"email=" + normalize(a.Email),
+		"phone=" + digits(a.Phone),
+		fmt.Sprintf("shipping_method=%d", s.ShippingMethod),
+	}
+
+	quantities := map[string]int{}
+	for _, li := range s.LineItems {
+		item := "sku:" + strings.TrimSpace(li.Sku)
+		if li.ProductId != "" {
+			item = fmt.Sprintf("product:%s/%d", li.ProductId, li.VariantId)
+		} else if li.Sku == "" {
+			item = fmt.Sprintf("blueprint:%d/%d/%d", li.BlueprintId, li.PrintProviderId, li.VariantId)
+		}
+		if len(li.PrintAreas) > 0 {
+			item += "|" + printAreas(li.PrintAreas)
+		}
+		quantities[item] += li.Quantity
+	}
+	items := make([]string, 0, len(quantities))
+	for item, qty := range quantities {
+		items = append(items, fmt.Sprintf("item=%s*%d", item, qty))
+	}
+	sort.Strings(items)
+
+	sum := sha256.Sum256([]byte(strings.Join(append(parts, items...), "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// Submitter submits orders at most once per Window.
+type Submitter struct {
+	Orders order.Client
+	ShopId int
+	Store  Store
+	// Window is how long submissions are remembered. Defaults to DefaultWindow.
+	Window time.Duration
+	// Mode selects what happens to a repeated submission. Defaults to ModeReturnExisting.
+	Mode ModeEnum
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	mu       sync.Mutex
+	inflight map[string]*keyLock
+}
+
+type keyLock struct {
+	sync.Mutex
+	waiters int
+}
+
+// NewSubmitter creates a Submitter with a MemoryStore that returns existing orders.
+func NewSubmitter(orders order.Client, shopId int) *Submitter {
+	return &Submitter{
+		Orders: orders,
+		ShopId: shopId,
+		Store:  NewMemoryStore(),
+		Mode:   ModeReturnExisting,
+	}
+}
+
+// Submit creates the order with SubmitOrder unless the same submission was seen
+// within Window.
+//
+// A repeated submission returns the existing order from GetOrderDetails, or a
+// *DuplicateError in ModeRefuse. Concurrent calls for the same submission are
+// serialized, so a double-click creates a single order.
+func (s *Submitter) Submit(body order.OrderSubmission) (*order.Order, error) {
+	return s.submit(body, s.Orders.SubmitOrder)
+}
+
+// SubmitExpress is Submit for SubmitPrintifyExpressOrder.
+func (s *Submitter) SubmitExpress(body order.OrderSubmission) (*order.Order, error) {
+	return s.submit(body, s.Orders.SubmitPrintifyExpressOrder)
+}
+
+func (s *Submitter) submit(body order.OrderSubmission, send func(idOne int, idTwo int, body order.OrderSubmission) (*order.Order, error)) (*order.Order, error) {
+	key := Key(body)
+	unlock := s.lock(key)
+	defer unlock()
+
+	now := s.now()
+	rec, ok, err := s.Store.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if ok && now.Sub(rec.SubmittedAt) < s.window() {
+		if s.Mode == ModeRefuse {
+			return nil, &DuplicateError{Record: rec}
+		}
+		return s.Orders.GetOrderDetails(s.ShopId, rec.OrderId)
+	}
+
+	created, err := send(s.ShopId, 0, body)
+	if err != nil {
+		return nil, err
+	}
+	err = s.Store.Put(Record{Key: key, OrderId: created.Id, ExternalId: body.ExternalId, SubmittedAt: now})
+	return created, err
+}
+
+// lock serializes submissions with the same key and returns the unlock function.
+func (s *Submitter) lock(key string) func() {
+	s.mu.Lock()
+	if s.inflight == nil {
+		s.inflight = map[string]*keyLock{}
+	}
+	l, ok := s.inflight[key]
+	if !ok {
+		l = &keyLock{}
+		s.inflight[key] = l
+	}
+	l.waiters++
+	s.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		s.mu.Lock()
+		if l.waiters--; l.waiters == 0 {
+			delete(s.inflight, key)
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (s *Submitter) window() time.Duration {
+	if s.Window > 0 {
+		return s.Window
+	}
+	return DefaultWindow
+}
+
+func (s *Submitter) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+// printAreas formats print areas by position, each with its images in layer order.
+func printAreas(areas map[string][]order.PrintAreaValue) string {
+	positions := make([]string, 0, len(areas))
+	for position, images := range areas {
+		srcs := make([]string, 0, len(images))
+		for _, img := range images {
+			srcs = append(srcs, fmt.Sprintf("%s@%g,%g,%g,%g", strings.TrimSpace(img.Src), img.X, img.Y, img.Scale, img.Angle))
+		}
+		positions = append(positions, strings.ToLower(strings.TrimSpace(position))+"="+strings.Join(srcs, ","))
+	}
+	sort.Strings(positions)
+	return strings.Join(positions, ";")
+}
+
+func normalize(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+func digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
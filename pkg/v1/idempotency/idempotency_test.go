@@ -0,0 +1,113 @@
+package idempotency
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+func newIdempotencyTestClient(register func(mux *http.ServeMux)) (*common.Client, func()) {
+	mux := http.NewServeMux()
+	register(mux)
+	srv := httptest.NewServer(mux)
+	c := common.NewClient("printify_pat", 123)
+	c.Host = srv.URL
+	return c, srv.Close
+}
+
+func exampleSubmission() order.OrderSubmission {
+	return order.OrderSubmission{
+		ExternalId: "EXT-1",
+		AddressTo:  order.Address{FirstName: "Ada", LastName: "Lovelace", Address1: "1 Main St", City: "Springfield", Zip: "12345", Country: "US"},
+		LineItems: []order.OrderSubmissionLineItem{
+			{ProductId: "prod_1", VariantId: 17887, Quantity: 1},
+			{Sku: "MUG-11", Quantity: 2},
+		},
+	}
+}
+
+func ExampleKey() {
+	a := exampleSubmission()
+	b := exampleSubmission()
+	b.AddressTo.Address1 = "  1 MAIN   st "
+	b.LineItems[0], b.LineItems[1] = b.LineItems[1], b.LineItems[0]
+	c := exampleSubmission()
+	c.LineItems[1].Quantity = 3
+	fmt.Println(Key(a) == Key(b), Key(a) == Key(c))
+	// Output: true false
+}
+
+func ExampleKey_personalized() {
+	personalized := func(src string) order.OrderSubmission {
+		return order.OrderSubmission{
+			AddressTo: order.Address{FirstName: "Ada", Address1: "1 Main St", City: "Springfield", Zip: "12345", Country: "US"},
+			LineItems: []order.OrderSubmissionLineItem{{
+				BlueprintId: 384, PrintProviderId: 1, VariantId: 45740, Quantity: 1,
+				PrintAreas: map[string][]order.PrintAreaValue{"front": {{Src: src, Scale: 1, X: 0.5, Y: 0.5}}},
+			}},
+		}
+	}
+	a := personalized("https://example.com/ada.png")
+	b := personalized("https://example.com/grace.png")
+	priority := personalized("https://example.com/ada.png")
+	priority.ShippingMethod = 2
+	fmt.Println(Key(a) == Key(b), Key(a) == Key(priority), Key(a) == Key(personalized("https://example.com/ada.png")))
+	// Output: false false true
+}
+
+func ExampleSubmitter_Submit() {
+	var mu sync.Mutex
+	created := 0
+	c, closeFn := newIdempotencyTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/orders.json", func(w http.ResponseWriter, _ *http.Request) {
+			mu.Lock()
+			created++
+			id := fmt.Sprintf("ord_%d", created)
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"id":"` + id + `"}`))
+		})
+		mux.HandleFunc("/v1/shops/123/orders/ord_1.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"ord_1","status":"on-hold"}`))
+		})
+	})
+	defer closeFn()
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	s := NewSubmitter(order.NewClient(c), 123)
+	s.Window = time.Hour
+	s.Now = func() time.Time { return now }
+
+	// A double-click submits the same order twice at once.
+	var wg sync.WaitGroup
+	ids := make([]string, 2)
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			o, _ := s.Submit(exampleSubmission())
+			ids[i] = o.Id
+		}(i)
+	}
+	wg.Wait()
+	fmt.Println(ids, "created:", created)
+
+	s.Mode = ModeRefuse
+	_, err := s.Submit(exampleSubmission())
+	var dup *DuplicateError
+	fmt.Println(errors.As(err, &dup), dup.Record.OrderId)
+
+	// Outside the window the submission is accepted again.
+	now = now.Add(2 * time.Hour)
+	o, _ := s.Submit(exampleSubmission())
+	fmt.Println(o.Id, "created:", created)
+	// Output:
+	// [ord_1 ord_1] created: 1
+	// true ord_1
+	// ord_2 created: 2
+}
@@ -0,0 +1,81 @@
+package idempotency
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ModeEnum selects what a Submitter does with a repeated submission.
+type ModeEnum string
+
+const (
+	// ModeReturnExisting returns the order created by the first submission.
+	ModeReturnExisting ModeEnum = "return_existing"
+	// ModeRefuse fails with a *DuplicateError.
+	ModeRefuse ModeEnum = "refuse"
+)
+
+// Record remembers a submitted order.
+type Record struct {
+	Key         string    `json:"key"`
+	OrderId     string    `json:"order_id"`
+	ExternalId  string    `json:"external_id"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+// Store remembers recent submissions.
+//
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the record for key. The bool is false when there is none.
+	Get(key string) (Record, bool, error)
+	// Put saves r, replacing any earlier record with the same key.
+	Put(r Record) error
+}
+
+// DuplicateError is returned in ModeRefuse when a submission was already seen.
+type DuplicateError struct {
+	Record Record
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("duplicate submission of %q: order %s was created at %s",
+		e.Record.ExternalId, e.Record.OrderId, e.Record.SubmittedAt.Format(time.RFC3339))
+}
+
+// MemoryStore is an in-memory Store.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: map[string]Record{}}
+}
+
+func (s *MemoryStore) Get(key string) (Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.records[key]
+	return r, ok, nil
+}
+
+func (s *MemoryStore) Put(r Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[r.Key] = r
+	return nil
+}
+
+// Prune forgets records submitted before cutoff.
+func (s *MemoryStore) Prune(cutoff time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, r := range s.records {
+		if r.SubmittedAt.Before(cutoff) {
+			delete(s.records, key)
+		}
+	}
+}
@@ -0,0 +1,351 @@
+package importer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+// Importer submits orders read from CSV files.
+//
+// Each CSV row is one line item. Rows sharing an external id form a single order,
+// and the order level columns (recipient, shipping method, ...) are taken from the
+// first row of the group.
+type Importer struct {
+	Orders  order.Client
+	ShopId  int
+	Mapping Mapping
+	// Store records submitted external ids. Orders submitted with a label can only be
+	// skipped on a rerun when Store keeps them between runs. Optional.
+	Store Store
+}
+
+// NewImporter creates an Importer using DefaultMapping and a MemoryStore.
+func NewImporter(orders order.Client, shopId int) *Importer {
+	return &Importer{
+		Orders:  orders,
+		ShopId:  shopId,
+		Mapping: DefaultMapping(),
+		Store:   NewMemoryStore(),
+	}
+}
+
+// Import parses r, validates every row and submits the valid orders with SubmitOrder.
+//
+// Orders whose external id already exists in the shop or in Store are skipped, so
+// running the same file twice does not create duplicate orders. Printify only reports
+// the external id of orders submitted without a label; labeled orders are recognized
+// through Store alone. An order with any invalid row is not submitted. The returned
+// error is only set when the file cannot be read, the existing orders cannot be
+// listed or Store fails; per-order problems are reported in the Result.
+func (im *Importer) Import(r io.Reader) (*Result, error) {
+	submissions, rowErrs, err := Parse(r, im.Mapping)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Errors: rowErrs, Invalid: invalidIds(rowErrs)}
+
+	existing, err := im.Orders.QueryOrders(order.ListOrdersOptions{})
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, o := range existing {
+		if id := o.ExternalId(); id != "" {
+			seen[id] = true
+		}
+	}
+
+	for _, s := range submissions {
+		if !seen[s.ExternalId] && im.Store != nil {
+			_, ok, err := im.Store.Get(s.ExternalId)
+			if err != nil {
+				return result, err
+			}
+			seen[s.ExternalId] = ok
+		}
+		if seen[s.ExternalId] {
+			result.Skipped = append(result.Skipped, s.ExternalId)
+			continue
+		}
+		created, err := im.Orders.SubmitOrder(im.ShopId, 0, s)
+		if err != nil {
+			result.Failed = append(result.Failed, Failed{ExternalId: s.ExternalId, Err: err})
+			continue
+		}
+		seen[s.ExternalId] = true
+		result.Submitted = append(result.Submitted, Submitted{ExternalId: s.ExternalId, OrderId: created.Id})
+		if im.Store != nil {
+			if err := im.Store.Put(s.ExternalId, created.Id); err != nil {
+				return result, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// Parse reads a CSV file with a header row and groups its rows into order submissions by external id.
+//
+// Only orders whose rows are all valid are returned; every problem found is reported as a RowError.
+// The error is set when the file cannot be read or required columns are missing from the header.
+func Parse(r io.Reader, m Mapping) ([]order.OrderSubmission, []RowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading header: %w", err)
+	}
+	cols := newColumns(header)
+	for _, required := range []string{m.ExternalId, m.Quantity} {
+		if !cols.has(required) {
+			return nil, nil, fmt.Errorf("missing required column: %s", required)
+		}
+	}
+	if !cols.has(m.ProductId) && !cols.has(m.Sku) {
+		return nil, nil, fmt.Errorf("missing required column: %s or %s", m.ProductId, m.Sku)
+	}
+
+	var (
+		groups  []*group
+		byId    = map[string]*group{}
+		rowErrs []RowError
+		rowNum  = 1
+	)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		rowNum++
+		row := row{num: rowNum, record: record, cols: cols, m: m}
+
+		externalId := row.get(m.ExternalId)
+		if externalId == "" {
+			rowErrs = append(rowErrs, row.errorf(m.ExternalId, "is required"))
+			continue
+		}
+		g, ok := byId[externalId]
+		if !ok {
+			g = &group{firstRow: row}
+			g.submission, g.errs = row.submission(externalId)
+			byId[externalId] = g
+			groups = append(groups, g)
+		} else {
+			g.errs = append(g.errs, row.conflicts(g.firstRow)...)
+		}
+
+		item, errs := row.lineItem()
+		g.errs = append(g.errs, errs...)
+		g.submission.LineItems = append(g.submission.LineItems, item)
+	}
+
+	var submissions []order.OrderSubmission
+	for _, g := range groups {
+		if len(g.errs) > 0 {
+			rowErrs = append(rowErrs, g.errs...)
+			continue
+		}
+		submissions = append(submissions, g.submission)
+	}
+	sort.SliceStable(rowErrs, func(i, j int) bool { return rowErrs[i].Row < rowErrs[j].Row })
+	return submissions, rowErrs, nil
+}
+
+type group struct {
+	firstRow   row
+	submission order.OrderSubmission
+	errs       []RowError
+}
+
+type columns map[string]int
+
+func newColumns(header []string) columns {
+	cols := columns{}
+	for i, name := range header {
+		cols[normalize(name)] = i
+	}
+	return cols
+}
+
+func (cols columns) has(name string) bool {
+	if name == "" {
+		return false
+	}
+	_, ok := cols[normalize(name)]
+	return ok
+}
+
+type row struct {
+	num    int
+	record []string
+	cols   columns
+	m      Mapping
+}
+
+func (r row) get(name string) string {
+	if name == "" {
+		return ""
+	}
+	i, ok := r.cols[normalize(name)]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+	return strings.TrimSpace(r.record[i])
+}
+
+func (r row) errorf(column string, format string, args ...interface{}) RowError {
+	return RowError{Row: r.num, ExternalId: r.get(r.m.ExternalId), Column: column, Message: fmt.Sprintf(format, args...)}
+}
+
+// orderColumns lists the order level columns that must agree across every row of an order.
+func (r row) orderColumns() []string {
+	m := r.m
+	return []string{
+		m.Label, m.ShippingMethod, m.SendShippingNotification, m.FirstName, m.LastName, m.Email, m.Phone,
+		m.Country, m.Region, m.Address1, m.Address2, m.City, m.Zip, m.Company,
+	}
+}
+
+func (r row) conflicts(first row) []RowError {
+	var errs []RowError
+	for _, name := range r.orderColumns() {
+		value := r.get(name)
+		if value != "" && value != first.get(name) {
+			errs = append(errs, r.errorf(name, "%q conflicts with %q on row %d", value, first.get(name), first.num))
+		}
+	}
+	return errs
+}
+
+func (r row) submission(externalId string) (order.OrderSubmission, []RowError) {
+	m := r.m
+	var errs []RowError
+	s := order.OrderSubmission{
+		ExternalId: externalId,
+		Label:      r.get(m.Label),
+		AddressTo: order.Address{
+			FirstName: r.get(m.FirstName),
+			LastName:  r.get(m.LastName),
+			Email:     r.get(m.Email),
+			Phone:     r.get(m.Phone),
+			Country:   strings.ToUpper(r.get(m.Country)),
+			Region:    r.get(m.Region),
+			Address1:  r.get(m.Address1),
+			Address2:  r.get(m.Address2),
+			City:      r.get(m.City),
+			Zip:       r.get(m.Zip),
+			Company:   r.get(m.Company),
+		},
+		ShippingMethod: 1,
+	}
+
+	for _, required := range []string{m.FirstName, m.LastName, m.Address1, m.City, m.Country} {
+		if r.get(required) == "" {
+			errs = append(errs, r.errorf(required, "is required"))
+		}
+	}
+	if country := s.AddressTo.Country; country != "" && !isCountryCode(country) {
+		errs = append(errs, r.errorf(m.Country, "%q is not a two-letter country code", country))
+	}
+	if email := s.AddressTo.Email; email != "" && !strings.Contains(email, "@") {
+		errs = append(errs, r.errorf(m.Email, "%q is not an email address", email))
+	}
+	if value := r.get(m.ShippingMethod); value != "" {
+		method, err := strconv.Atoi(value)
+		if err != nil || method < 1 || method > 4 {
+			errs = append(errs, r.errorf(m.ShippingMethod, "%q must be 1, 2, 3 or 4", value))
+		}
+		s.ShippingMethod = method
+	}
+	if value := r.get(m.SendShippingNotification); value != "" {
+		notify, err := strconv.ParseBool(value)
+		if err != nil {
+			errs = append(errs, r.errorf(m.SendShippingNotification, "%q is not a boolean", value))
+		}
+		s.SendShippingNotification = notify
+	}
+	return s, errs
+}
+
+func (r row) lineItem() (order.OrderSubmissionLineItem, []RowError) {
+	m := r.m
+	var errs []RowError
+	item := order.OrderSubmissionLineItem{
+		ProductId: r.get(m.ProductId),
+		Sku:       r.get(m.Sku),
+	}
+
+	quantity, err := strconv.Atoi(r.get(m.Quantity))
+	if err != nil || quantity < 1 {
+		errs = append(errs, r.errorf(m.Quantity, "%q must be a positive integer", r.get(m.Quantity)))
+	}
+	item.Quantity = quantity
+
+	intFields := []struct {
+		column string
+		dst    *int
+	}{
+		{m.VariantId, &item.VariantId},
+		{m.PrintProviderId, &item.PrintProviderId},
+		{m.BlueprintId, &item.BlueprintId},
+	}
+	for _, f := range intFields {
+		value := r.get(f.column)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			errs = append(errs, r.errorf(f.column, "%q is not an integer", value))
+			continue
+		}
+		*f.dst = n
+	}
+
+	switch {
+	case item.ProductId != "" && r.get(m.VariantId) == "":
+		errs = append(errs, r.errorf(m.VariantId, "is required with %s", m.ProductId))
+	case item.ProductId == "" && item.Sku == "":
+		errs = append(errs, r.errorf("", "either %s and %s, or %s is required", m.ProductId, m.VariantId, m.Sku))
+	}
+	return item, errs
+}
+
+func invalidIds(errs []RowError) []string {
+	var ids []string
+	seen := map[string]bool{}
+	for _, e := range errs {
+		if e.ExternalId == "" || seen[e.ExternalId] {
+			continue
+		}
+		seen[e.ExternalId] = true
+		ids = append(ids, e.ExternalId)
+	}
+	return ids
+}
+
+func isCountryCode(s string) bool {
+	if len(s) != 2 {
+		return false
+	}
+	for _, c := range s {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func normalize(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
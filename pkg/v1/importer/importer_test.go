@@ -0,0 +1,102 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+const exampleCSV = `external_id,first_name,last_name,address1,city,zip,country,product_id,variant_id,quantity
+EXT-1,Ada,Lovelace,1 Main St,Springfield,12345,US,prod_1,17887,1
+EXT-2,Alan,Turing,2 High St,London,N1 9GU,gb,prod_1,17887,2
+EXT-2,,,,,,,prod_2,12100,1
+EXT-3,Grace,Hopper,3 Navy Rd,Arlington,22201,US,prod_1,17887,zero
+`
+
+func newImporterTestClient(register func(mux *http.ServeMux)) (*common.Client, func()) {
+	mux := http.NewServeMux()
+	register(mux)
+	srv := httptest.NewServer(mux)
+	c := common.NewClient("printify_pat", 123)
+	c.Host = srv.URL
+	return c, srv.Close
+}
+
+func ExampleParse() {
+	submissions, rowErrs, _ := Parse(strings.NewReader(exampleCSV), DefaultMapping())
+	for _, s := range submissions {
+		fmt.Println(s.ExternalId, s.AddressTo.Country, len(s.LineItems))
+	}
+	for _, e := range rowErrs {
+		fmt.Println(e)
+	}
+	// Output:
+	// EXT-1 US 1
+	// EXT-2 GB 2
+	// row 5 (EXT-3): quantity: "zero" must be a positive integer
+}
+
+func ExampleImporter_Import() {
+	c, closeFn := newImporterTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/orders.json", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				_, _ = w.Write([]byte(`{"data":[{"id":"ord_1","metadata":{"shop_order_label":"EXT-1"}}]}`))
+				return
+			}
+			var s order.OrderSubmission
+			_ = json.NewDecoder(r.Body).Decode(&s)
+			_, _ = w.Write([]byte(`{"id":"ord_` + s.ExternalId + `"}`))
+		})
+	})
+	defer closeFn()
+
+	im := NewImporter(order.NewClient(c), 123)
+	result, _ := im.Import(strings.NewReader(exampleCSV))
+	fmt.Printf("submitted=%v skipped=%v invalid=%v\n", result.Submitted, result.Skipped, result.Invalid)
+	// Output: submitted=[{EXT-2 ord_EXT-2}] skipped=[EXT-1] invalid=[EXT-3]
+}
+
+func ExampleImporter_Import_rerunWithLabels() {
+	const labeled = `external_id,label,first_name,last_name,address1,city,country,product_id,variant_id,quantity
+EXT-1,Order #1001,Ada,Lovelace,1 Main St,Springfield,US,prod_1,17887,1
+EXT-2,Order #1002,Alan,Turing,2 High St,London,GB,prod_1,17887,2
+`
+	var created []string
+	c, closeFn := newImporterTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/orders.json", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				// Printify reports the submitted label, not the external id.
+				var orders []order.Order
+				for i, label := range created {
+					orders = append(orders, order.Order{Id: fmt.Sprint("ord_", i), Metadata: order.OrderMetadata{ShopOrderLabel: label}})
+				}
+				_ = json.NewEncoder(w).Encode(map[string]any{"data": orders})
+				return
+			}
+			var s order.OrderSubmission
+			_ = json.NewDecoder(r.Body).Decode(&s)
+			created = append(created, s.Label)
+			_, _ = w.Write([]byte(`{"id":"ord_` + s.ExternalId + `"}`))
+		})
+	})
+	defer closeFn()
+
+	// The same Store is shared by both runs, as a persistent store would be across processes.
+	store := NewMemoryStore()
+	for run := 1; run <= 2; run++ {
+		im := NewImporter(order.NewClient(c), 123)
+		im.Store = store
+		result, _ := im.Import(strings.NewReader(labeled))
+		fmt.Printf("run %d: submitted=%d skipped=%v\n", run, len(result.Submitted), result.Skipped)
+	}
+	fmt.Println(created)
+	// Output:
+	// run 1: submitted=2 skipped=[]
+	// run 2: submitted=0 skipped=[EXT-1 EXT-2]
+	// [Order #1001 Order #1002]
+}
@@ -0,0 +1,142 @@
+package importer
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Mapping maps OrderSubmission and Address fields to CSV header names.
+//
+// Header matching ignores case and surrounding whitespace. An empty column name
+// means the field is not read from the file.
+type Mapping struct {
+	ExternalId               string
+	Label                    string
+	ShippingMethod           string
+	SendShippingNotification string
+
+	FirstName string
+	LastName  string
+	Email     string
+	Phone     string
+	Country   string
+	Region    string
+	Address1  string
+	Address2  string
+	City      string
+	Zip       string
+	Company   string
+
+	ProductId       string
+	VariantId       string
+	Sku             string
+	Quantity        string
+	PrintProviderId string
+	BlueprintId     string
+}
+
+// DefaultMapping returns a Mapping whose column names match the Printify JSON field names.
+func DefaultMapping() Mapping {
+	return Mapping{
+		ExternalId:               "external_id",
+		Label:                    "label",
+		ShippingMethod:           "shipping_method",
+		SendShippingNotification: "send_shipping_notification",
+		FirstName:                "first_name",
+		LastName:                 "last_name",
+		Email:                    "email",
+		Phone:                    "phone",
+		Country:                  "country",
+		Region:                   "region",
+		Address1:                 "address1",
+		Address2:                 "address2",
+		City:                     "city",
+		Zip:                      "zip",
+		Company:                  "company",
+		ProductId:                "product_id",
+		VariantId:                "variant_id",
+		Sku:                      "sku",
+		Quantity:                 "quantity",
+		PrintProviderId:          "print_provider_id",
+		BlueprintId:              "blueprint_id",
+	}
+}
+
+// RowError describes a validation problem found on a single CSV row.
+type RowError struct {
+	// Row is the 1-based line number in the file, counting the header as row 1.
+	Row        int
+	ExternalId string
+	Column     string
+	Message    string
+}
+
+func (e RowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("row %d (%s): %s", e.Row, e.ExternalId, e.Message)
+	}
+	return fmt.Sprintf("row %d (%s): %s: %s", e.Row, e.ExternalId, e.Column, e.Message)
+}
+
+// Submitted records an order that was created in Printify.
+type Submitted struct {
+	ExternalId string
+	OrderId    string
+}
+
+// Failed records an order that passed validation but was rejected by SubmitOrder.
+type Failed struct {
+	ExternalId string
+	Err        error
+}
+
+// Result summarizes an Import run.
+type Result struct {
+	// Submitted lists orders created during this run.
+	Submitted []Submitted
+	// Skipped lists external ids that already exist in Printify or in the Store and were not submitted again.
+	Skipped []string
+	// Invalid lists external ids that were not submitted because at least one of their rows failed validation.
+	Invalid []string
+	// Failed lists orders rejected by the API.
+	Failed []Failed
+	// Errors lists every row-level validation problem.
+	Errors []RowError
+}
+
+// Store records the external ids of submitted orders.
+//
+// Printify reports an order's external id only as its shop_order_label, which a
+// submitted label replaces, so orders imported with a label can only be recognized
+// on a later run through a Store that outlives the process.
+type Store interface {
+	// Get returns the order id submitted for externalId. The bool is false when none was recorded.
+	Get(externalId string) (string, bool, error)
+	// Put records that externalId was submitted as orderId.
+	Put(externalId string, orderId string) error
+}
+
+// MemoryStore is an in-memory Store.
+type MemoryStore struct {
+	mu     sync.Mutex
+	orders map[string]string
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{orders: map[string]string{}}
+}
+
+func (s *MemoryStore) Get(externalId string) (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id, ok := s.orders[externalId]
+	return id, ok, nil
+}
+
+func (s *MemoryStore) Put(externalId string, orderId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.orders[externalId] = orderId
+	return nil
+}
@@ -0,0 +1,100 @@
+package order
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+)
+
+// CancellableStatuses lists the order statuses Printify allows to be canceled.
+var CancellableStatuses = []string{"on-hold", "payment-not-received"}
+
+// IsCancellable reports whether an order in the given status can be canceled.
+func IsCancellable(status string) bool {
+	for _, s := range CancellableStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// CancelOutcomeEnum is the result of canceling one order with CancelOrders.
+type CancelOutcomeEnum string
+
+const (
+	CancelOutcomeCancelled      CancelOutcomeEnum = "cancelled"
+	CancelOutcomeNotCancellable CancelOutcomeEnum = "not-cancellable"
+	CancelOutcomeFailed         CancelOutcomeEnum = "failed"
+)
+
+// CancelResult is the outcome of canceling one order with CancelOrders.
+type CancelResult struct {
+	OrderId string
+	Outcome CancelOutcomeEnum
+	// Status is the order's status before cancellation, when it could be fetched.
+	Status string
+	// Reason explains why the order is not cancellable.
+	Reason string
+	// Order is the canceled order returned by Printify.
+	Order *Order
+	// Err is the error returned while fetching or canceling a failed order.
+	Err error
+}
+
+// cancelConcurrency bounds the number of orders CancelOrders processes at once.
+const cancelConcurrency = 5
+
+var (
+	// CancelOrders fetches each order with GetOrderDetails, checks its status with
+	// IsCancellable and cancels the eligible ones concurrently with CancelOrder.
+	//
+	// Signature:
+	//	func(c *common.Client, idOne int, idTwo []string) []CancelResult
+	// Parameter mapping:
+	//	idOne -> {shopId}
+	//	idTwo -> {orderId} of every order to cancel
+	//
+	// One CancelResult is returned per order id, in the same order. Orders that are
+	// not cancellable are never POSTed to the cancel endpoint.
+	CancelOrders = func(c *common.Client, idOne int, idTwo []string) []CancelResult {
+		results := make([]CancelResult, len(idTwo))
+		sem := make(chan struct{}, cancelConcurrency)
+		var wg sync.WaitGroup
+		for i, orderId := range idTwo {
+			wg.Add(1)
+			go func(r *CancelResult, orderId string) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				*r = cancelOne(c, idOne, orderId)
+			}(&results[i], orderId)
+		}
+		wg.Wait()
+		return results
+	}
+)
+
+func cancelOne(c *common.Client, shopId int, orderId string) CancelResult {
+	r := CancelResult{OrderId: orderId}
+	o, err := GetOrderDetails(c, shopId, orderId)
+	if err != nil {
+		r.Outcome, r.Err = CancelOutcomeFailed, err
+		return r
+	}
+	r.Status = o.Status
+	if !IsCancellable(o.Status) {
+		r.Outcome = CancelOutcomeNotCancellable
+		r.Reason = fmt.Sprintf("order is %s; only %s orders can be canceled", o.Status, strings.Join(CancellableStatuses, " or "))
+		return r
+	}
+	canceled, err := CancelOrder(c, shopId, orderId)
+	if err != nil {
+		r.Outcome, r.Err = CancelOutcomeFailed, err
+		return r
+	}
+	r.Outcome, r.Order = CancelOutcomeCancelled, canceled
+	return r
+}
@@ -0,0 +1,42 @@
+package order
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func ExampleCancelOrders() {
+	c, closeFn := newOrderTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/orders/ord_1.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"ord_1","status":"on-hold"}`))
+		})
+		mux.HandleFunc("/v1/shops/123/orders/ord_1/cancel.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"ord_1","status":"canceled"}`))
+		})
+		mux.HandleFunc("/v1/shops/123/orders/ord_2.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"ord_2","status":"in-production"}`))
+		})
+		mux.HandleFunc("/v1/shops/123/orders/ord_3.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"ord_3","status":"payment-not-received"}`))
+		})
+		mux.HandleFunc("/v1/shops/123/orders/ord_3/cancel.json", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		})
+	})
+	defer closeFn()
+
+	for _, r := range CancelOrders(c, 123, []string{"ord_1", "ord_2", "ord_3"}) {
+		switch r.Outcome {
+		case CancelOutcomeCancelled:
+			fmt.Println(r.OrderId, r.Outcome, r.Order.Status)
+		case CancelOutcomeNotCancellable:
+			fmt.Println(r.OrderId, r.Outcome, r.Reason)
+		case CancelOutcomeFailed:
+			fmt.Println(r.OrderId, r.Outcome, r.Status, r.Err != nil)
+		}
+	}
+	// Output:
+	// ord_1 cancelled canceled
+	// ord_2 not-cancellable order is in-production; only on-hold or payment-not-received orders can be canceled
+	// ord_3 failed payment-not-received true
+}
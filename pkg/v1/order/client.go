@@ -9,12 +9,14 @@ import (
 // Client defines order operations and enables dependency injection.
 type Client interface {
 	ListOrders() ([]Order, error)
-	GetOrderDetails(idOne int, idTwo int) (*Order, error)
-	SubmitOrder(idOne int, idTwo int, body Order) (*Order, error)
-	SubmitPrintifyExpressOrder(idOne int, idTwo int, body Order) (*Order, error)
-	SendOrderToProduction(idOne int, idTwo int, body Order) error
+	QueryOrders(opts ListOrdersOptions) ([]Order, error)
+	GetOrderDetails(idOne int, idTwo string) (*Order, error)
+	SubmitOrder(idOne int, idTwo int, body OrderSubmission) (*Order, error)
+	SubmitPrintifyExpressOrder(idOne int, idTwo int, body OrderSubmission) (*Order, error)
+	SendOrderToProduction(idOne int, idTwo string, body Order) error
 	CalculateShippingCosts(id int, body ShipmentCalculationRequest) (*ShipmentCalculationResponse, error)
-	CancelOrder(idOne int, idTwo int) (*Order, error)
+	CancelOrder(idOne int, idTwo string) (*Order, error)
+	CancelOrders(idOne int, idTwo []string) []CancelResult
 }
 
 type client struct {
@@ -30,19 +32,23 @@ func (cl *client) ListOrders() ([]Order, error) {
 	return ListOrders(cl.c)
 }
 
-func (cl *client) GetOrderDetails(idOne int, idTwo int) (*Order, error) {
+func (cl *client) QueryOrders(opts ListOrdersOptions) ([]Order, error) {
+	return QueryOrders(cl.c, opts)
+}
+
+func (cl *client) GetOrderDetails(idOne int, idTwo string) (*Order, error) {
 	return GetOrderDetails(cl.c, idOne, idTwo)
 }
 
-func (cl *client) SubmitOrder(idOne int, idTwo int, body Order) (*Order, error) {
+func (cl *client) SubmitOrder(idOne int, idTwo int, body OrderSubmission) (*Order, error) {
 	return SubmitOrder(cl.c, idOne, idTwo, body)
 }
 
-func (cl *client) SubmitPrintifyExpressOrder(idOne int, idTwo int, body Order) (*Order, error) {
+func (cl *client) SubmitPrintifyExpressOrder(idOne int, idTwo int, body OrderSubmission) (*Order, error) {
 	return SubmitPrintifyExpressOrder(cl.c, idOne, idTwo, body)
 }
 
-func (cl *client) SendOrderToProduction(idOne int, idTwo int, body Order) error {
+func (cl *client) SendOrderToProduction(idOne int, idTwo string, body Order) error {
 	return SendOrderToProduction(cl.c, idOne, idTwo, body)
 }
 
@@ -50,19 +56,23 @@ func (cl *client) CalculateShippingCosts(id int, body ShipmentCalculationRequest
 	return CalculateShippingCosts(cl.c, id, body)
 }
 
-func (cl *client) CancelOrder(idOne int, idTwo int) (*Order, error) {
+func (cl *client) CancelOrder(idOne int, idTwo string) (*Order, error) {
 	return CancelOrder(cl.c, idOne, idTwo)
 }
 
+func (cl *client) CancelOrders(idOne int, idTwo []string) []CancelResult {
+	return CancelOrders(cl.c, idOne, idTwo)
+}
+
 var (
 	ENDPOINT                               = "/v1/shops"
 	LIST_ORDERS_ENDPOINT                   = fmt.Sprintf("%s/%%d/orders.json", ENDPOINT)
-	GET_ORDER_DETAILS_ENDPOINT             = fmt.Sprintf("%s/%%d/orders/%%d.json", ENDPOINT)
+	GET_ORDER_DETAILS_ENDPOINT             = fmt.Sprintf("%s/%%d/orders/%%s.json", ENDPOINT)
 	SUBMIT_ORDER_ENDPOINT                  = fmt.Sprintf("%s/%%d/orders.json", ENDPOINT)
 	SUBMIT_PRINTIFY_EXPRESS_ORDER_ENDPOINT = fmt.Sprintf("%s/%%d/orders/express.json", ENDPOINT)
-	SEND_ORDER_TO_PRODUCTION_ENDPOINT      = fmt.Sprintf("%s/%%d/orders/%%d/send_to_production.json", ENDPOINT)
+	SEND_ORDER_TO_PRODUCTION_ENDPOINT      = fmt.Sprintf("%s/%%d/orders/%%s/send_to_production.json", ENDPOINT)
 	CALCULATE_SHIPPING_COSTS_ENDPOINT      = fmt.Sprintf("%s/%%d/orders/shipping.json", ENDPOINT)
-	CANCEL_ORDER_ENDPOINT                  = fmt.Sprintf("%s/%%d/orders/%%d/cancel.json", ENDPOINT)
+	CANCEL_ORDER_ENDPOINT                  = fmt.Sprintf("%s/%%d/orders/%%s/cancel.json", ENDPOINT)
 )
 
 var (
@@ -79,48 +89,48 @@ var (
 	// GetOrderDetails calls GET /v1/shops/{shopId}/orders/{orderId}.json.
 	//
 	// Signature:
-	//	func(c *common.Client, idOne int, idTwo int) (*Order, error)
+	//	func(c *common.Client, idOne int, idTwo string) (*Order, error)
 	// Parameter mapping:
 	//	idOne -> {shopId}
 	//	idTwo -> {orderId}
 	//
 	// shopId can be discovered with shop.ListShops.
 	// orderId can be discovered with ListOrders for the same shop.
-	GetOrderDetails = common.GetResourceWithTwoId[Order, int, int](GET_ORDER_DETAILS_ENDPOINT)
+	GetOrderDetails = common.GetResourceWithTwoId[Order, int, string](GET_ORDER_DETAILS_ENDPOINT)
 	// SubmitOrder calls POST /v1/shops/{shopId}/orders.json to create an order.
 	//
 	// Signature:
-	//	func(c *common.Client, idOne int, idTwo int, body Order) (*Order, error)
+	//	func(c *common.Client, idOne int, idTwo int, body OrderSubmission) (*Order, error)
 	// Parameter mapping:
 	//	idOne -> {shopId}
 	//	idTwo -> ignored by this endpoint
-	//	body -> order payload
+	//	body -> order submission payload
 	//
 	// shopId can be discovered with shop.ListShops.
 	// idTwo is unused because this endpoint has only one path identifier.
-	SubmitOrder = func(c *common.Client, idOne int, idTwo int, body Order) (*Order, error) {
+	SubmitOrder = func(c *common.Client, idOne int, idTwo int, body OrderSubmission) (*Order, error) {
 		_ = idTwo
-		return common.PostResourceWithReturnAndId[Order, Order, int](SUBMIT_ORDER_ENDPOINT)(c, idOne, body)
+		return common.PostResourceWithReturnAndId[OrderSubmission, Order, int](SUBMIT_ORDER_ENDPOINT)(c, idOne, body)
 	}
 	// SubmitPrintifyExpressOrder calls POST /v1/shops/{shopId}/orders/express.json.
 	//
 	// Signature:
-	//	func(c *common.Client, idOne int, idTwo int, body Order) (*Order, error)
+	//	func(c *common.Client, idOne int, idTwo int, body OrderSubmission) (*Order, error)
 	// Parameter mapping:
 	//	idOne -> {shopId}
 	//	idTwo -> ignored by this endpoint
-	//	body -> order payload
+	//	body -> order submission payload
 	//
 	// shopId can be discovered with shop.ListShops.
 	// idTwo is unused because this endpoint has only one path identifier.
-	SubmitPrintifyExpressOrder = func(c *common.Client, idOne int, idTwo int, body Order) (*Order, error) {
+	SubmitPrintifyExpressOrder = func(c *common.Client, idOne int, idTwo int, body OrderSubmission) (*Order, error) {
 		_ = idTwo
-		return common.PostResourceWithReturnAndId[Order, Order, int](SUBMIT_PRINTIFY_EXPRESS_ORDER_ENDPOINT)(c, idOne, body)
+		return common.PostResourceWithReturnAndId[OrderSubmission, Order, int](SUBMIT_PRINTIFY_EXPRESS_ORDER_ENDPOINT)(c, idOne, body)
 	}
 	// SendOrderToProduction calls POST /v1/shops/{shopId}/orders/{orderId}/send_to_production.json.
 	//
 	// Signature:
-	//	func(c *common.Client, idOne int, idTwo int, body Order) error
+	//	func(c *common.Client, idOne int, idTwo string, body Order) error
 	// Parameter mapping:
 	//	idOne -> {shopId}
 	//	idTwo -> {orderId}
@@ -128,7 +138,7 @@ var (
 	//
 	// shopId can be discovered with shop.ListShops.
 	// orderId can be discovered with ListOrders for the same shop.
-	SendOrderToProduction = common.PostResourceWithoutReturnTwoId[Order, int, int](SEND_ORDER_TO_PRODUCTION_ENDPOINT)
+	SendOrderToProduction = common.PostResourceWithoutReturnTwoId[Order, int, string](SEND_ORDER_TO_PRODUCTION_ENDPOINT)
 	// CalculateShippingCosts calls POST /v1/shops/{shopId}/orders/shipping.json.
 	//
 	// Signature:
@@ -142,12 +152,12 @@ var (
 	// CancelOrder calls POST /v1/shops/{shopId}/orders/{orderId}/cancel.json.
 	//
 	// Signature:
-	//	func(c *common.Client, idOne int, idTwo int) (*Order, error)
+	//	func(c *common.Client, idOne int, idTwo string) (*Order, error)
 	// Parameter mapping:
 	//	idOne -> {shopId}
 	//	idTwo -> {orderId}
 	//
 	// shopId can be discovered with shop.ListShops.
 	// orderId can be discovered with ListOrders for the same shop.
-	CancelOrder = common.PostNoResourceWithReturnTwoId[Order, int, int](CANCEL_ORDER_ENDPOINT)
+	CancelOrder = common.PostNoResourceWithReturnTwoId[Order, int, string](CANCEL_ORDER_ENDPOINT)
 )
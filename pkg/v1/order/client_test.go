@@ -34,7 +34,7 @@ func ExampleListOrders() {
 
 	items, _ := ListOrders(c)
 	fmt.Printf("%#v\n", items[0])
-	// Output: order.Order{Id:"ord_1", AddressTo:order.Address{FirstName:"", LastName:"", Region:"", Address1:"", City:"", Zip:"", Email:"", Phone:"", Country:"", Company:""}, LineItems:[]order.LineItem(nil), Metadata:order.OrderMetadata{OrderType:"", ShopOrderId:0, ShopOrderLabel:"", ShopFulfilledAt:""}, TotalPrice:0, TotalShipping:0, TotalTax:0, Status:"", ShippingMethod:0, IsPrintifyExpress:false, IsEconomyShipping:false, Shipments:[]order.Shipment(nil), CreatedAt:"", SentToProductionAt:"", FulfilledAt:"", PrintifyConnect:order.PrintifyConnect{Url:"", Id:""}}
+	// Output: order.Order{Id:"ord_1", AddressTo:order.Address{FirstName:"", LastName:"", Region:"", Address1:"", Address2:"", City:"", Zip:"", Email:"", Phone:"", Country:"", Company:""}, LineItems:[]order.LineItem(nil), Metadata:order.OrderMetadata{OrderType:"", ShopOrderId:0, ShopOrderLabel:"", ShopFulfilledAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)}, TotalPrice:common.Money{Amount:0, Currency:""}, TotalShipping:common.Money{Amount:0, Currency:""}, TotalTax:common.Money{Amount:0, Currency:""}, Status:"", ShippingMethod:0, IsPrintifyExpress:false, IsEconomyShipping:false, Shipments:[]order.Shipment(nil), CreatedAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), SentToProductionAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), FulfilledAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), PrintifyConnect:order.PrintifyConnect{Url:"", Id:""}}
 }
 
 func ExampleGetOrderDetails() {
@@ -45,9 +45,9 @@ func ExampleGetOrderDetails() {
 	})
 	defer closeFn()
 
-	item, _ := GetOrderDetails(c, 123, 456)
+	item, _ := GetOrderDetails(c, 123, "456")
 	fmt.Printf("%#v\n", *item)
-	// Output: order.Order{Id:"ord_456", AddressTo:order.Address{FirstName:"", LastName:"", Region:"", Address1:"", City:"", Zip:"", Email:"", Phone:"", Country:"", Company:""}, LineItems:[]order.LineItem(nil), Metadata:order.OrderMetadata{OrderType:"", ShopOrderId:0, ShopOrderLabel:"", ShopFulfilledAt:""}, TotalPrice:0, TotalShipping:0, TotalTax:0, Status:"", ShippingMethod:0, IsPrintifyExpress:false, IsEconomyShipping:false, Shipments:[]order.Shipment(nil), CreatedAt:"", SentToProductionAt:"", FulfilledAt:"", PrintifyConnect:order.PrintifyConnect{Url:"", Id:""}}
+	// Output: order.Order{Id:"ord_456", AddressTo:order.Address{FirstName:"", LastName:"", Region:"", Address1:"", Address2:"", City:"", Zip:"", Email:"", Phone:"", Country:"", Company:""}, LineItems:[]order.LineItem(nil), Metadata:order.OrderMetadata{OrderType:"", ShopOrderId:0, ShopOrderLabel:"", ShopFulfilledAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)}, TotalPrice:common.Money{Amount:0, Currency:""}, TotalShipping:common.Money{Amount:0, Currency:""}, TotalTax:common.Money{Amount:0, Currency:""}, Status:"", ShippingMethod:0, IsPrintifyExpress:false, IsEconomyShipping:false, Shipments:[]order.Shipment(nil), CreatedAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), SentToProductionAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), FulfilledAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), PrintifyConnect:order.PrintifyConnect{Url:"", Id:""}}
 }
 
 func ExampleSubmitOrder() {
@@ -58,9 +58,9 @@ func ExampleSubmitOrder() {
 	})
 	defer closeFn()
 
-	item, _ := SubmitOrder(c, 123, 0, Order{})
+	item, _ := SubmitOrder(c, 123, 0, OrderSubmission{})
 	fmt.Printf("%#v\n", *item)
-	// Output: order.Order{Id:"ord_submit", AddressTo:order.Address{FirstName:"", LastName:"", Region:"", Address1:"", City:"", Zip:"", Email:"", Phone:"", Country:"", Company:""}, LineItems:[]order.LineItem(nil), Metadata:order.OrderMetadata{OrderType:"", ShopOrderId:0, ShopOrderLabel:"", ShopFulfilledAt:""}, TotalPrice:0, TotalShipping:0, TotalTax:0, Status:"", ShippingMethod:0, IsPrintifyExpress:false, IsEconomyShipping:false, Shipments:[]order.Shipment(nil), CreatedAt:"", SentToProductionAt:"", FulfilledAt:"", PrintifyConnect:order.PrintifyConnect{Url:"", Id:""}}
+	// Output: order.Order{Id:"ord_submit", AddressTo:order.Address{FirstName:"", LastName:"", Region:"", Address1:"", Address2:"", City:"", Zip:"", Email:"", Phone:"", Country:"", Company:""}, LineItems:[]order.LineItem(nil), Metadata:order.OrderMetadata{OrderType:"", ShopOrderId:0, ShopOrderLabel:"", ShopFulfilledAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)}, TotalPrice:common.Money{Amount:0, Currency:""}, TotalShipping:common.Money{Amount:0, Currency:""}, TotalTax:common.Money{Amount:0, Currency:""}, Status:"", ShippingMethod:0, IsPrintifyExpress:false, IsEconomyShipping:false, Shipments:[]order.Shipment(nil), CreatedAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), SentToProductionAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), FulfilledAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), PrintifyConnect:order.PrintifyConnect{Url:"", Id:""}}
 }
 
 func ExampleSubmitPrintifyExpressOrder() {
@@ -71,9 +71,9 @@ func ExampleSubmitPrintifyExpressOrder() {
 	})
 	defer closeFn()
 
-	item, _ := SubmitPrintifyExpressOrder(c, 123, 0, Order{})
+	item, _ := SubmitPrintifyExpressOrder(c, 123, 0, OrderSubmission{})
 	fmt.Printf("%#v\n", *item)
-	// Output: order.Order{Id:"ord_express", AddressTo:order.Address{FirstName:"", LastName:"", Region:"", Address1:"", City:"", Zip:"", Email:"", Phone:"", Country:"", Company:""}, LineItems:[]order.LineItem(nil), Metadata:order.OrderMetadata{OrderType:"", ShopOrderId:0, ShopOrderLabel:"", ShopFulfilledAt:""}, TotalPrice:0, TotalShipping:0, TotalTax:0, Status:"", ShippingMethod:0, IsPrintifyExpress:false, IsEconomyShipping:false, Shipments:[]order.Shipment(nil), CreatedAt:"", SentToProductionAt:"", FulfilledAt:"", PrintifyConnect:order.PrintifyConnect{Url:"", Id:""}}
+	// Output: order.Order{Id:"ord_express", AddressTo:order.Address{FirstName:"", LastName:"", Region:"", Address1:"", Address2:"", City:"", Zip:"", Email:"", Phone:"", Country:"", Company:""}, LineItems:[]order.LineItem(nil), Metadata:order.OrderMetadata{OrderType:"", ShopOrderId:0, ShopOrderLabel:"", ShopFulfilledAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)}, TotalPrice:common.Money{Amount:0, Currency:""}, TotalShipping:common.Money{Amount:0, Currency:""}, TotalTax:common.Money{Amount:0, Currency:""}, Status:"", ShippingMethod:0, IsPrintifyExpress:false, IsEconomyShipping:false, Shipments:[]order.Shipment(nil), CreatedAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), SentToProductionAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), FulfilledAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), PrintifyConnect:order.PrintifyConnect{Url:"", Id:""}}
 }
 
 func ExampleSendOrderToProduction() {
@@ -84,7 +84,7 @@ func ExampleSendOrderToProduction() {
 	})
 	defer closeFn()
 
-	err := SendOrderToProduction(c, 123, 456, Order{})
+	err := SendOrderToProduction(c, 123, "456", Order{})
 	fmt.Println(err == nil)
 	// Output: true
 }
@@ -99,7 +99,7 @@ func ExampleCalculateShippingCosts() {
 
 	item, _ := CalculateShippingCosts(c, 123, ShipmentCalculationRequest{})
 	fmt.Printf("%#v\n", *item)
-	// Output: order.ShipmentCalculationResponse{Standard:500, Express:900, Priority:700, PrintifyExpress:1100, Economy:300}
+	// Output: order.ShipmentCalculationResponse{Standard:common.Money{Amount:500, Currency:""}, Express:common.Money{Amount:900, Currency:""}, Priority:common.Money{Amount:700, Currency:""}, PrintifyExpress:common.Money{Amount:1100, Currency:""}, Economy:common.Money{Amount:300, Currency:""}}
 }
 
 func ExampleCancelOrder() {
@@ -110,7 +110,7 @@ func ExampleCancelOrder() {
 	})
 	defer closeFn()
 
-	item, _ := CancelOrder(c, 123, 456)
+	item, _ := CancelOrder(c, 123, "456")
 	fmt.Printf("%#v\n", *item)
-	// Output: order.Order{Id:"ord_cancel", AddressTo:order.Address{FirstName:"", LastName:"", Region:"", Address1:"", City:"", Zip:"", Email:"", Phone:"", Country:"", Company:""}, LineItems:[]order.LineItem(nil), Metadata:order.OrderMetadata{OrderType:"", ShopOrderId:0, ShopOrderLabel:"", ShopFulfilledAt:""}, TotalPrice:0, TotalShipping:0, TotalTax:0, Status:"", ShippingMethod:0, IsPrintifyExpress:false, IsEconomyShipping:false, Shipments:[]order.Shipment(nil), CreatedAt:"", SentToProductionAt:"", FulfilledAt:"", PrintifyConnect:order.PrintifyConnect{Url:"", Id:""}}
+	// Output: order.Order{Id:"ord_cancel", AddressTo:order.Address{FirstName:"", LastName:"", Region:"", Address1:"", Address2:"", City:"", Zip:"", Email:"", Phone:"", Country:"", Company:""}, LineItems:[]order.LineItem(nil), Metadata:order.OrderMetadata{OrderType:"", ShopOrderId:0, ShopOrderLabel:"", ShopFulfilledAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)}, TotalPrice:common.Money{Amount:0, Currency:""}, TotalShipping:common.Money{Amount:0, Currency:""}, TotalTax:common.Money{Amount:0, Currency:""}, Status:"", ShippingMethod:0, IsPrintifyExpress:false, IsEconomyShipping:false, Shipments:[]order.Shipment(nil), CreatedAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), SentToProductionAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), FulfilledAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), PrintifyConnect:order.PrintifyConnect{Url:"", Id:""}}
 }
@@ -0,0 +1,138 @@
+package order
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+	"github.com/connellrobert/printify-go/pkg/v1/pagination"
+)
+
+// ListOrdersOptions filters the orders returned by QueryOrders.
+//
+// Status, Sku, Page and Limit are sent to Printify as query parameters. The
+// remaining fields are applied client-side to every order returned.
+type ListOrdersOptions struct {
+	// Status filters by order status, for example "on-hold" or "fulfilled".
+	Status string
+	// Sku returns only orders containing a line item with this SKU.
+	Sku string
+	// Page fetches a single page. When zero, QueryOrders walks every page.
+	Page int
+	// Limit sets the page size requested from Printify.
+	Limit int
+
+	// CreatedFrom and CreatedTo restrict orders to those created in [CreatedFrom, CreatedTo).
+	// A zero value leaves that side of the range open.
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// Country matches the recipient's country code, ignoring case.
+	Country string
+	// ExternalId matches the sales channel identifier returned by Order.ExternalId.
+	ExternalId string
+	// PrintProviderId returns only orders with a line item fulfilled by this print provider.
+	PrintProviderId int
+	// Filter is an additional predicate; orders for which it returns false are dropped.
+	Filter func(o Order) bool
+}
+
+// Query returns the server-side filters as URL query parameters.
+func (opts ListOrdersOptions) Query() url.Values {
+	query := url.Values{}
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
+	}
+	if opts.Sku != "" {
+		query.Set("sku", opts.Sku)
+	}
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Limit > 0 {
+		query.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	return query
+}
+
+// Match reports whether an order satisfies the client-side filters.
+func (opts ListOrdersOptions) Match(o Order) bool {
+	if !opts.CreatedFrom.IsZero() || !opts.CreatedTo.IsZero() {
+		if o.CreatedAt.IsZero() {
+			return false
+		}
+		if !opts.CreatedFrom.IsZero() && o.CreatedAt.Before(opts.CreatedFrom) {
+			return false
+		}
+		if !opts.CreatedTo.IsZero() && !o.CreatedAt.Before(opts.CreatedTo) {
+			return false
+		}
+	}
+	if opts.Country != "" && !strings.EqualFold(o.AddressTo.Country, opts.Country) {
+		return false
+	}
+	if opts.ExternalId != "" && o.ExternalId() != opts.ExternalId {
+		return false
+	}
+	if opts.PrintProviderId != 0 {
+		found := false
+		for _, li := range o.LineItems {
+			if li.PrintProviderId == opts.PrintProviderId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if opts.Filter != nil && !opts.Filter(o) {
+		return false
+	}
+	return true
+}
+
+var (
+	// ListOrdersPage calls GET /v1/shops/{shopId}/orders.json with the server-side filters
+	// from opts and returns a single page, including its pagination metadata.
+	//
+	// Signature:
+	//	func(c *common.Client, opts ListOrdersOptions) (*pagination.APIPagination[Order], error)
+	//
+	// The shop id used by this endpoint is taken from the client that created the request.
+	// Client-side filters in opts are not applied; use QueryOrders for that.
+	ListOrdersPage = func(c *common.Client, opts ListOrdersOptions) (*pagination.APIPagination[Order], error) {
+		return common.ListPageWithId[Order, int](LIST_ORDERS_ENDPOINT)(c, c.ShopID, opts.Query())
+	}
+	// QueryOrders calls GET /v1/shops/{shopId}/orders.json and returns the orders matching opts.
+	//
+	// Signature:
+	//	func(c *common.Client, opts ListOrdersOptions) ([]Order, error)
+	//
+	// When opts.Page is zero every page is fetched in turn, starting at page 1.
+	// The shop id used by this endpoint is taken from the client that created the request.
+	QueryOrders = func(c *common.Client, opts ListOrdersOptions) ([]Order, error) {
+		walk := opts.Page == 0
+		if walk {
+			opts.Page = 1
+		}
+
+		var orders []Order
+		for {
+			page, err := ListOrdersPage(c, opts)
+			if err != nil {
+				return nil, err
+			}
+			for _, o := range page.Data {
+				if opts.Match(o) {
+					orders = append(orders, o)
+				}
+			}
+			if !walk || len(page.Data) == 0 || opts.Page >= page.LastPage {
+				return orders, nil
+			}
+			opts.Page++
+		}
+	}
+)
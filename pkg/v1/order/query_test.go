@@ -0,0 +1,50 @@
+package order
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+func ExampleListOrdersOptions_Query() {
+	opts := ListOrdersOptions{Status: "on-hold", Sku: "TEE-BLK-M", Limit: 10}
+	fmt.Println(opts.Query().Encode())
+	// Output: limit=10&sku=TEE-BLK-M&status=on-hold
+}
+
+func ExampleQueryOrders() {
+	c, closeFn := newOrderTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/orders.json", func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("page") {
+			case "1":
+				_, _ = w.Write([]byte(`{"current_page":1,"last_page":2,"data":[{"id":"ord_1","status":"on-hold","created_at":"2024-03-04 09:00:00+00:00","address_to":{"country":"US"}},{"id":"ord_2","status":"on-hold","created_at":"2024-02-01 09:00:00+00:00","address_to":{"country":"US"}}]}`))
+			case "2":
+				_, _ = w.Write([]byte(`{"current_page":2,"last_page":2,"data":[{"id":"ord_3","status":"on-hold","created_at":"2024-03-05 09:00:00+00:00","address_to":{"country":"GB"}}]}`))
+			}
+		})
+	})
+	defer closeFn()
+
+	items, _ := QueryOrders(c, ListOrdersOptions{
+		Status:      "on-hold",
+		CreatedFrom: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		Country:     "us",
+	})
+	for _, o := range items {
+		fmt.Println(o.Id)
+	}
+	// Output: ord_1
+}
+
+func ExampleListOrdersPage() {
+	c, closeFn := newOrderTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/orders.json", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"current_page":%s,"last_page":4,"data":[{"id":"ord_7"}]}`, r.URL.Query().Get("page"))
+		})
+	})
+	defer closeFn()
+
+	page, _ := ListOrdersPage(c, ListOrdersOptions{Page: 3})
+	fmt.Println(page.CurrentPage, page.LastPage, page.Data[0].Id)
+	// Output: 3 4 ord_7
+}
@@ -1,5 +1,9 @@
 package order
 
+import (
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+)
+
 // Order represents an order resource returned by shop order endpoints.
 type Order struct {
 	// A unique string identifier for the order. Each id is unique across the Printify system.
@@ -11,11 +15,11 @@ type Order struct {
 	// Other data about the order. See OrderMetadata properties for reference.
 	Metadata OrderMetadata `json:"metadata"`
 	// Retail price in cents, integer value.
-	TotalPrice int `json:"total_price"`
+	TotalPrice common.Money `json:"total_price"`
 	// Shipping price in cents, integer value.
-	TotalShipping int `json:"total_shipping"`
+	TotalShipping common.Money `json:"total_shipping"`
 	// Tax cost in cents, integer value.
-	TotalTax int `json:"total_tax"`
+	TotalTax common.Money `json:"total_tax"`
 	// Production status of the entire order in string format, it can be any of the following:
 	// Status	Definition
 	// pending	An order is created in the pending status. Orders should not stay in this status for a long time.
@@ -48,22 +52,31 @@ type Order struct {
 	// Tracking details of the order after fulfillment. See shipment properties for reference.
 	Shipments []Shipment `json:"shipments"`
 	// The date and time the order was created. It is stored in ISO date format.
-	CreatedAt string `json:"created_at"`
+	CreatedAt common.Timestamp `json:"created_at"`
 	// The date and time the order was sent to production. It is stored in ISO date format.
-	SentToProductionAt string `json:"sent_to_production_at"`
+	SentToProductionAt common.Timestamp `json:"sent_to_production_at"`
 	// The date and time the order was fulfilled. It is stored in ISO date format.
-	FulfilledAt string `json:"fulfilled_at"`
+	FulfilledAt common.Timestamp `json:"fulfilled_at"`
 	// Printify Connect data containing link to the order in the Printify Connect page and the unique hash for the order.
 	// More about Printify Connect can be read in our Help Center or Blog.
 	PrintifyConnect PrintifyConnect `json:"printify_connect"`
 }
 
+// ExternalId returns the sales channel identifier of the order.
+//
+// Printify stores the external_id of an OrderSubmission as the order's shop_order_label
+// unless a separate label was submitted.
+func (o Order) ExternalId() string {
+	return o.Metadata.ShopOrderLabel
+}
+
 // Address represents a recipient shipping address.
 type Address struct {
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
 	Region    string `json:"region"`
 	Address1  string `json:"address1"`
+	Address2  string `json:"address2"`
 	City      string `json:"city"`
 	Zip       string `json:"zip"`
 	Email     string `json:"email"`
@@ -83,9 +96,9 @@ type LineItem struct {
 	// A unique int identifier for the print provider. Each id is unique across the Printify system.
 	PrintProviderId int `json:"print_provider_id"`
 	// Product variant's fulfillment cost in cents, integer value.
-	Cost int `json:"cost"`
+	Cost common.Money `json:"cost"`
 	// Product variant's shipment cost in cents, integer value.
-	ShippingCost int `json:"shipping_cost"`
+	ShippingCost common.Money `json:"shipping_cost"`
 	// Specific line item fulfillment status:
 	// Status	Definition
 	// on-hold
@@ -103,9 +116,9 @@ type LineItem struct {
 	// Other details about the specific product variant. See line item metadata properties for reference.
 	Metadata LineItemMetadata `json:"metadata"`
 	// The date and time the product variant was sent to production. It is stored in ISO date format.
-	SentToProductionAt string `json:"sent_to_production_at"`
+	SentToProductionAt common.Timestamp `json:"sent_to_production_at"`
 	// The date and time the product variant was fulfilled. It is stored in ISO date format.
-	FulfilledAt string `json:"fulfilled_at"`
+	FulfilledAt common.Timestamp `json:"fulfilled_at"`
 }
 
 // LineItemMetadata contains descriptive metadata for an order line item.
@@ -113,7 +126,7 @@ type LineItemMetadata struct {
 	// The name of the product.
 	Title string `json:"title"`
 	// Retail price in cents, integer value.
-	Price int `json:"price"`
+	Price common.Money `json:"price"`
 	// Name of the product variant.
 	VariantLabel string `json:"variant_label"`
 	// A unique string identifier for the product variant.
@@ -131,7 +144,7 @@ type OrderMetadata struct {
 	// A unique string identifier for the order in the external sales channel.
 	ShopOrderLabel string `json:"shop_order_label"`
 	// The date and time the order was fulfilled. It is stored in ISO date format.
-	ShopFulfilledAt string `json:"shop_fulfilled_at"`
+	ShopFulfilledAt common.Timestamp `json:"shop_fulfilled_at"`
 }
 
 // Shipment represents shipping/tracking information for a fulfilled order.
@@ -143,7 +156,7 @@ type Shipment struct {
 	// A unique string tracking link from the shipping courier used to track the status of the shipment.
 	Url string `json:"url"`
 	// The date and time the order was delivered. It is stored in ISO date format.
-	DeliveredAt string `json:"delivered_at"`
+	DeliveredAt common.Timestamp `json:"delivered_at"`
 }
 
 // PrintifyConnect contains identifiers and links for the Printify Connect UI.
@@ -169,11 +182,17 @@ type OrderSubmission struct {
 }
 
 // OrderSubmissionLineItem represents one line item in an order submission payload.
+//
+// Existing products are ordered with ProductId and VariantId, or with Sku alone.
+// Products created on the fly from the order need BlueprintId, PrintProviderId, VariantId and PrintAreas.
 type OrderSubmissionLineItem struct {
-	PrintProviderId int                         `json:"print_provider_id"`
-	BlueprintId     int                         `json:"blueprint_id"`
-	VariantId       int                         `json:"variant_id"`
-	PrintAreas      map[string][]PrintAreaValue `json:"print_areas"`
+	ProductId       string                      `json:"product_id,omitempty"`
+	Sku             string                      `json:"sku,omitempty"`
+	Quantity        int                         `json:"quantity"`
+	PrintProviderId int                         `json:"print_provider_id,omitempty"`
+	BlueprintId     int                         `json:"blueprint_id,omitempty"`
+	VariantId       int                         `json:"variant_id,omitempty"`
+	PrintAreas      map[string][]PrintAreaValue `json:"print_areas,omitempty"`
 }
 
 // PrintAreaValue represents image placement coordinates for custom print areas.
@@ -193,9 +212,9 @@ type ShipmentCalculationRequest struct {
 
 // ShipmentCalculationResponse represents calculated shipping prices by shipping method.
 type ShipmentCalculationResponse struct {
-	Standard        int `json:"standard"`
-	Express         int `json:"express"`
-	Priority        int `json:"priority"`
-	PrintifyExpress int `json:"printify_express"`
-	Economy         int `json:"economy"`
+	Standard        common.Money `json:"standard"`
+	Express         common.Money `json:"express"`
+	Priority        common.Money `json:"priority"`
+	PrintifyExpress common.Money `json:"printify_express"`
+	Economy         common.Money `json:"economy"`
 }
@@ -0,0 +1,121 @@
+package placement
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/connellrobert/printify-go/pkg/v1/catalog"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+	"github.com/connellrobert/printify-go/pkg/v1/uploads"
+)
+
+// Compute places an image of size img on a placeholder of size area.
+//
+// The image, rotated by opts.Angle, is sized by opts.Mode within the area left by
+// opts.Margins. Placeholder pixels are assumed to print at opts.PrintDPI, so an image
+// shown at its native size prints at that resolution.
+func Compute(img Size, area Size, opts Options) (Placement, error) {
+	if img.Width <= 0 || img.Height <= 0 {
+		return Placement{}, fmt.Errorf("image size %gx%g is not positive", img.Width, img.Height)
+	}
+	if area.Width <= 0 || area.Height <= 0 {
+		return Placement{}, fmt.Errorf("placeholder size %gx%g is not positive", area.Width, area.Height)
+	}
+	m := opts.Margins
+	if m.Top < 0 || m.Right < 0 || m.Bottom < 0 || m.Left < 0 {
+		return Placement{}, errors.New("margins must not be negative")
+	}
+	inner := Size{Width: area.Width - m.Left - m.Right, Height: area.Height - m.Top - m.Bottom}
+	if inner.Width <= 0 || inner.Height <= 0 {
+		return Placement{}, fmt.Errorf("margins leave no room on a %gx%g placeholder", area.Width, area.Height)
+	}
+	dpi := opts.PrintDPI
+	if dpi <= 0 {
+		dpi = DefaultPrintDPI
+	}
+
+	bounds := Rotated(img, opts.Angle)
+	fit := math.Min(inner.Width/bounds.Width, inner.Height/bounds.Height)
+	// factor is the number of placeholder pixels per image pixel.
+	var factor float64
+	switch opts.Mode {
+	case ModeFit, ModeTop, "":
+		factor = fit
+	case ModeFill:
+		factor = math.Max(inner.Width/bounds.Width, inner.Height/bounds.Height)
+	case ModeCenter:
+		factor = math.Min(1, fit)
+	default:
+		return Placement{}, fmt.Errorf("unsupported mode: %s", opts.Mode)
+	}
+
+	cx := m.Left + inner.Width/2
+	cy := m.Top + inner.Height/2
+	if opts.Mode == ModeTop {
+		cy = m.Top + bounds.Height*factor/2
+	}
+	return Placement{
+		X:       cx / area.Width,
+		Y:       cy / area.Height,
+		Scale:   img.Width * factor / area.Width,
+		Angle:   opts.Angle,
+		Printed: Size{Width: img.Width * factor, Height: img.Height * factor},
+		DPI:     dpi / factor,
+	}, nil
+}
+
+// Place computes the placement of an uploaded image on a catalog placeholder.
+func Place(img uploads.Image, ph catalog.Placeholder, opts Options) (Placement, error) {
+	p, err := Compute(
+		Size{Width: float64(img.Width), Height: float64(img.Height)},
+		Size{Width: float64(ph.Width), Height: float64(ph.Height)},
+		opts,
+	)
+	if err != nil {
+		return Placement{}, fmt.Errorf("%s on %s: %w", img.Id, ph.Position, err)
+	}
+	return p, nil
+}
+
+// Image returns a product image layer for the uploaded image at this placement.
+func (p Placement) Image(img uploads.Image) product.Image {
+	return product.Image{
+		Id:     img.Id,
+		Name:   img.FileName,
+		Type:   img.MimeType,
+		Height: float64(img.Height),
+		Width:  float64(img.Width),
+		X:      p.X,
+		Y:      p.Y,
+		Scale:  p.Scale,
+		Angle:  p.Angle,
+	}
+}
+
+// Rotated returns the bounding box of size rotated by angle degrees.
+func Rotated(size Size, angle int) Size {
+	rad := float64(angle) * math.Pi / 180
+	sin, cos := math.Abs(math.Sin(rad)), math.Abs(math.Cos(rad))
+	return Size{
+		Width:  round(size.Width*cos + size.Height*sin),
+		Height: round(size.Width*sin + size.Height*cos),
+	}
+}
+
+// EffectiveDPI returns the print resolution of an image imageWidth pixels wide shown
+// at scale on a placeholder placeholderWidth pixels wide printing at printDPI.
+func EffectiveDPI(imageWidth, placeholderWidth int, scale, printDPI float64) float64 {
+	if placeholderWidth <= 0 || scale <= 0 {
+		return 0
+	}
+	if printDPI <= 0 {
+		printDPI = DefaultPrintDPI
+	}
+	return printDPI * float64(imageWidth) / (scale * float64(placeholderWidth))
+}
+
+// round drops floating point noise such as the 1e-13 left by cos(90°).
+func round(v float64) float64 {
+	return math.Round(v*1e6) / 1e6
+}
@@ -0,0 +1,51 @@
+package placement
+
+import (
+	"fmt"
+
+	"github.com/connellrobert/printify-go/pkg/v1/catalog"
+	"github.com/connellrobert/printify-go/pkg/v1/uploads"
+)
+
+func ExampleCompute() {
+	img := Size{Width: 1800, Height: 1200}
+	area := Size{Width: 3600, Height: 4800}
+	for _, opts := range []Options{
+		{Mode: ModeFit},
+		{Mode: ModeFill},
+		{Mode: ModeCenter},
+		{Mode: ModeTop},
+		{Mode: ModeFit, Margins: Uniform(300)},
+		{Mode: ModeFit, Angle: 90},
+	} {
+		p, _ := Compute(img, area, opts)
+		fmt.Printf("%s margins=%g angle=%d: x=%.3g y=%.3g scale=%.3g dpi=%.4g\n", opts.Mode, opts.Margins.Top, opts.Angle, p.X, p.Y, p.Scale, p.DPI)
+	}
+	// Output:
+	// fit margins=0 angle=0: x=0.5 y=0.5 scale=1 dpi=150
+	// fill margins=0 angle=0: x=0.5 y=0.5 scale=2 dpi=75
+	// center margins=0 angle=0: x=0.5 y=0.5 scale=0.5 dpi=300
+	// top margins=0 angle=0: x=0.5 y=0.25 scale=1 dpi=150
+	// fit margins=300 angle=0: x=0.5 y=0.5 scale=0.833 dpi=180
+	// fit margins=0 angle=90: x=0.5 y=0.5 scale=1.33 dpi=112.5
+}
+
+func ExamplePlace() {
+	upload := uploads.Image{Id: "img_1", FileName: "logo.png", MimeType: "image/png", Width: 4500, Height: 4500}
+	front := catalog.Placeholder{Position: "front", Width: 3555, Height: 3995}
+
+	p, _ := Place(upload, front, Options{Mode: ModeTop, Margins: Margins{Top: 200}})
+	img := p.Image(upload)
+	fmt.Printf("%s x=%.2f y=%.3f scale=%.2f dpi=%.0f\n", img.Id, img.X, img.Y, img.Scale, p.DPI)
+
+	_, err := Place(upload, catalog.Placeholder{Position: "sleeve"}, Options{})
+	fmt.Println(err)
+	// Output:
+	// img_1 x=0.50 y=0.495 scale=1.00 dpi=380
+	// img_1 on sleeve: placeholder size 0x0 is not positive
+}
+
+func ExampleEffectiveDPI() {
+	fmt.Printf("%.0f\n", EffectiveDPI(2000, 4000, 1, 0))
+	// Output: 150
+}
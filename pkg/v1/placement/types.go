@@ -0,0 +1,63 @@
+package placement
+
+// DefaultPrintDPI is the resolution catalog placeholder dimensions are expressed in.
+const DefaultPrintDPI = 300
+
+// ModeEnum selects how an image is sized and aligned within a placeholder.
+type ModeEnum string
+
+const (
+	// ModeFit scales the image to fit entirely inside the area, centered.
+	ModeFit ModeEnum = "fit"
+	// ModeFill scales the image to cover the whole area, centered. Parts outside the area are cropped.
+	ModeFill ModeEnum = "fill"
+	// ModeCenter centers the image at its native print size, shrinking it to fit when it is too large.
+	ModeCenter ModeEnum = "center"
+	// ModeTop scales the image like ModeFit and aligns it to the top edge of the area.
+	ModeTop ModeEnum = "top"
+)
+
+// Size is a width and height in pixels.
+type Size struct {
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// Margins inset the area an image is placed in, in placeholder pixels.
+type Margins struct {
+	Top    float64 `json:"top"`
+	Right  float64 `json:"right"`
+	Bottom float64 `json:"bottom"`
+	Left   float64 `json:"left"`
+}
+
+// Uniform returns Margins of m pixels on every side.
+func Uniform(m float64) Margins {
+	return Margins{Top: m, Right: m, Bottom: m, Left: m}
+}
+
+// Options configure a placement.
+type Options struct {
+	// Mode defaults to ModeFit.
+	Mode ModeEnum
+	// Margins leave room between the area's edges and the image.
+	Margins Margins
+	// Angle rotates the image clockwise in degrees. The rotated bounding box is what is fitted.
+	Angle int
+	// PrintDPI is the resolution of placeholder pixels. Defaults to DefaultPrintDPI.
+	PrintDPI float64
+}
+
+// Placement is an image position in the relative coordinates used by product.Image.
+type Placement struct {
+	// X and Y locate the image center as a fraction of the placeholder width and height.
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	// Scale is the width of the unrotated image as a fraction of the placeholder width.
+	Scale float64 `json:"scale"`
+	Angle int     `json:"angle"`
+	// Printed is the size of the unrotated image on the placeholder, in placeholder pixels.
+	Printed Size `json:"printed"`
+	// DPI is the effective print resolution of the image.
+	DPI float64 `json:"dpi"`
+}
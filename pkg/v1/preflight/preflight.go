@@ -0,0 +1,226 @@
+package preflight
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/connellrobert/printify-go/pkg/v1/catalog"
+	"github.com/connellrobert/printify-go/pkg/v1/placement"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+	"github.com/connellrobert/printify-go/pkg/v1/uploads"
+)
+
+// Checker inspects a product's artwork before CreateProduct or UpdateProduct.
+type Checker struct {
+	Catalog catalog.Client
+	// Uploads looks up the size and type of images that do not carry them. Optional.
+	Uploads uploads.Client
+	// MinDPI is the lowest accepted effective resolution. Defaults to DefaultMinDPI.
+	MinDPI float64
+	// PrintDPI is the resolution of placeholder pixels. Defaults to placement.DefaultPrintDPI.
+	PrintDPI float64
+	// Formats are the accepted image types. Defaults to DefaultFormats.
+	Formats []string
+	// RequireTransparency flags images without any transparent pixel, such as artwork
+	// exported on a white background. Checking it downloads each image from its Src.
+	RequireTransparency bool
+	// HTTPClient downloads images. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// NewChecker creates a Checker with the default thresholds.
+func NewChecker(c catalog.Client) *Checker {
+	return &Checker{Catalog: c}
+}
+
+// Check runs every check against each image layer of p.
+//
+// The effective DPI of a layer is computed against the widest placeholder offered at
+// its position by the variants of its print area. Text layers are skipped. The error
+// is only set when the catalog variants cannot be fetched.
+func (c *Checker) Check(p product.Product) (*Report, error) {
+	variants, err := c.Catalog.ListVariantsByBlueprintPrintProvider(p.BlueprintId, p.PrintProviderId)
+	if err != nil {
+		return nil, err
+	}
+	placeholders := map[int]map[string]catalog.Placeholder{}
+	for _, v := range variants {
+		placeholders[v.Id] = map[string]catalog.Placeholder{}
+		for _, ph := range v.Placeholders {
+			placeholders[v.Id][ph.Position] = ph
+		}
+	}
+
+	report := &Report{ProductId: p.Id}
+	meta := map[string]*uploads.Image{}
+	opaque := map[string]*bool{}
+	for _, area := range p.PrintAreas {
+		for _, ph := range area.Placeholders {
+			var widest catalog.Placeholder
+			for _, id := range area.VariantIds {
+				if offered, ok := placeholders[id][ph.Position]; ok && offered.Width > widest.Width {
+					widest = offered
+				}
+			}
+			if widest.Width == 0 {
+				report.Issues = append(report.Issues, Issue{
+					Check: CheckPlaceholder, Severity: SeverityError, Position: ph.Position,
+					Message: fmt.Sprintf("no variant of the print area offers a %q placeholder", ph.Position),
+				})
+				continue
+			}
+			for layer, img := range ph.Images {
+				if img.IsText() {
+					continue
+				}
+				issue := func(check CheckEnum, severity SeverityEnum, format string, args ...any) *Issue {
+					report.Issues = append(report.Issues, Issue{
+						Check: check, Severity: severity, Position: ph.Position, Layer: layer, ImageId: img.Id,
+						Message: fmt.Sprintf(format, args...),
+					})
+					return &report.Issues[len(report.Issues)-1]
+				}
+
+				width, mimeType := int(img.Width), imageType(img)
+				if (width == 0 || mimeType == "") && c.Uploads != nil && img.Id != "" {
+					if _, ok := meta[img.Id]; !ok {
+						meta[img.Id], _ = c.Uploads.GetUploadedImage(img.Id)
+					}
+					if u := meta[img.Id]; u != nil {
+						width = max(width, u.Width)
+						if mimeType == "" {
+							mimeType = strings.ToLower(u.MimeType)
+						}
+					}
+				}
+
+				switch {
+				case width == 0:
+					issue(CheckMetadata, SeverityWarning, "image width is unknown")
+				case img.Scale <= 0:
+					issue(CheckResolution, SeverityError, "scale %g is not positive", img.Scale)
+				default:
+					dpi := placement.EffectiveDPI(width, widest.Width, img.Scale, c.PrintDPI)
+					if dpi < c.minDPI() {
+						issue(CheckResolution, SeverityError, "prints at %.0f DPI, below %.0f", dpi, c.minDPI()).DPI = math.Round(dpi)
+					}
+				}
+
+				if mimeType == "" {
+					issue(CheckMetadata, SeverityWarning, "image type is unknown")
+				} else if !c.accepts(mimeType) {
+					issue(CheckFormat, SeverityError, "%s is not an accepted type", mimeType)
+				}
+
+				if !c.RequireTransparency {
+					continue
+				}
+				if mimeType != "" && mimeType != "image/png" {
+					issue(CheckTransparency, SeverityError, "%s cannot be transparent", mimeType)
+					continue
+				}
+				if _, ok := opaque[img.Src]; !ok {
+					opaque[img.Src] = nil
+					if o, err := c.opaque(img.Src); err != nil {
+						issue(CheckMetadata, SeverityWarning, "transparency not checked: %s", err)
+					} else {
+						opaque[img.Src] = &o
+					}
+				}
+				if o := opaque[img.Src]; o != nil && *o {
+					issue(CheckTransparency, SeverityError, "image has no transparent pixels")
+				}
+			}
+		}
+	}
+	return report, nil
+}
+
+// CheckAll runs Check against every product.
+func (c *Checker) CheckAll(products []product.Product) ([]Report, error) {
+	reports := make([]Report, 0, len(products))
+	for _, p := range products {
+		r, err := c.Check(p)
+		if err != nil {
+			return reports, fmt.Errorf("product %s: %w", p.Id, err)
+		}
+		reports = append(reports, *r)
+	}
+	return reports, nil
+}
+
+func (c *Checker) minDPI() float64 {
+	if c.MinDPI > 0 {
+		return c.MinDPI
+	}
+	return DefaultMinDPI
+}
+
+func (c *Checker) accepts(mimeType string) bool {
+	formats := c.Formats
+	if len(formats) == 0 {
+		formats = DefaultFormats
+	}
+	for _, f := range formats {
+		if strings.EqualFold(f, mimeType) {
+			return true
+		}
+	}
+	return false
+}
+
+// opaque downloads the image at src and reports whether every pixel is fully opaque.
+func (c *Checker) opaque(src string) (bool, error) {
+	if src == "" {
+		return false, fmt.Errorf("image has no src")
+	}
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Get(src)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("download %s: %s", src, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return false, err
+	}
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque(), nil
+	}
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a != 0xffff {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// imageType returns the MIME type of img from its Type, falling back to its file name.
+func imageType(img product.Image) string {
+	if img.Type != "" {
+		return strings.ToLower(img.Type)
+	}
+	return mime.TypeByExtension(strings.ToLower(path.Ext(img.Name)))
+}
@@ -0,0 +1,87 @@
+package preflight
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+	"github.com/connellrobert/printify-go/pkg/v1/catalog"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+	"github.com/connellrobert/printify-go/pkg/v1/uploads"
+)
+
+func newPreflightTestClient(register func(mux *http.ServeMux)) (*common.Client, func()) {
+	mux := http.NewServeMux()
+	register(mux)
+	srv := httptest.NewServer(mux)
+	c := common.NewClient("printify_pat", 123)
+	c.Host = srv.URL
+	return c, srv.Close
+}
+
+func pngBytes(alpha uint8) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 2; x++ {
+			img.Set(x, y, color.NRGBA{R: 255, A: alpha})
+		}
+	}
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, img)
+	return buf.Bytes()
+}
+
+func ExampleChecker_Check() {
+	c, closeFn := newPreflightTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/catalog/blueprints/6/print_providers/99/variants.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[
+{"id":1,"placeholders":[{"position":"front","width":3600,"height":4800},{"position":"back","width":3600,"height":4800}]},
+{"id":2,"placeholders":[{"position":"front","width":4000,"height":4800}]}]`))
+		})
+		mux.HandleFunc("/v1/uploads/img_vector.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"img_vector","width":6000,"height":6000,"mime_type":"image/svg+xml"}`))
+		})
+		mux.HandleFunc("/opaque.png", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(pngBytes(255))
+		})
+		mux.HandleFunc("/transparent.png", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(pngBytes(0))
+		})
+	})
+	defer closeFn()
+	host := c.Host
+
+	p := product.Product{Id: "prod_1", BlueprintId: 6, PrintProviderId: 99, PrintAreas: []product.PrintArea{{
+		VariantIds: []int{1, 2},
+		Placeholders: []product.Placeholder{
+			{Position: "front", Images: []product.Image{
+				{Id: "img_small", Src: host + "/opaque.png", Type: "image/png", Width: 1500, Scale: 1},
+				{Id: "txt", InputText: "Hello", FontFamily: "Roboto"},
+				{Id: "img_vector", Scale: 0.5},
+				{Id: "img_logo", Src: host + "/transparent.png", Name: "logo.png", Width: 4000, Scale: 0.5},
+			}},
+			{Position: "sleeve", Images: []product.Image{{Id: "img_logo"}}},
+		},
+	}}}
+
+	checker := NewChecker(catalog.NewClient(c))
+	checker.Uploads = uploads.NewClient(c)
+	checker.RequireTransparency = true
+	report, _ := checker.Check(p)
+	for _, issue := range report.Issues {
+		fmt.Println(issue)
+	}
+	fmt.Println("ok:", report.OK())
+	// Output:
+	// error: front[0] resolution: prints at 112 DPI, below 150
+	// error: front[0] transparency: image has no transparent pixels
+	// error: front[2] format: image/svg+xml is not an accepted type
+	// error: front[2] transparency: image/svg+xml cannot be transparent
+	// error: sleeve[0] placeholder: no variant of the print area offers a "sleeve" placeholder
+	// ok: false
+}
@@ -0,0 +1,75 @@
+package preflight
+
+import (
+	"fmt"
+)
+
+// DefaultMinDPI is the lowest effective resolution accepted by default.
+const DefaultMinDPI = 150
+
+// DefaultFormats are the image types Printify accepts for print files.
+var DefaultFormats = []string{"image/png", "image/jpeg", "image/jpg"}
+
+// CheckEnum names the check that raised an Issue.
+type CheckEnum string
+
+const (
+	// CheckResolution flags images printing below the minimum DPI.
+	CheckResolution CheckEnum = "resolution"
+	// CheckFormat flags images of a type that is not accepted.
+	CheckFormat CheckEnum = "format"
+	// CheckTransparency flags images without transparent pixels when transparency is required.
+	CheckTransparency CheckEnum = "transparency"
+	// CheckPlaceholder flags positions the product's variants do not offer.
+	CheckPlaceholder CheckEnum = "placeholder"
+	// CheckMetadata flags images whose size or type could not be determined.
+	CheckMetadata CheckEnum = "metadata"
+)
+
+// SeverityEnum tells whether an Issue should block the product.
+type SeverityEnum string
+
+const (
+	SeverityError   SeverityEnum = "error"
+	SeverityWarning SeverityEnum = "warning"
+)
+
+// Issue is a problem found with one image layer.
+type Issue struct {
+	Check    CheckEnum    `json:"check"`
+	Severity SeverityEnum `json:"severity"`
+	// Position is the placeholder position, for example "front".
+	Position string `json:"position"`
+	// Layer is the index of the image within its placeholder.
+	Layer   int    `json:"layer"`
+	ImageId string `json:"image_id,omitempty"`
+	// DPI is the effective resolution, set by resolution checks.
+	DPI     float64 `json:"dpi,omitempty"`
+	Message string  `json:"message"`
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s[%d] %s: %s", i.Severity, i.Position, i.Layer, i.Check, i.Message)
+}
+
+// Report lists every issue found in a product.
+type Report struct {
+	ProductId string  `json:"product_id,omitempty"`
+	Issues    []Issue `json:"issues"`
+}
+
+// OK reports whether no issue is an error.
+func (r Report) OK() bool {
+	return len(r.Errors()) == 0
+}
+
+// Errors returns the issues with SeverityError.
+func (r Report) Errors() []Issue {
+	var out []Issue
+	for _, i := range r.Issues {
+		if i.Severity == SeverityError {
+			out = append(out, i)
+		}
+	}
+	return out
+}
@@ -0,0 +1,163 @@
+package pricing
+
+import (
+	"math"
+	"strings"
+
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+)
+
+// Price computes the price for a variant cost and the titles of its option values.
+// The bool reports whether the price was capped.
+func (r Rules) Price(cost common.Money, options map[string]string) (common.Money, bool) {
+	currency := cost.Currency
+	if currency == "" {
+		currency = r.Currency
+	}
+
+	amount := -1
+	surcharge := 0
+	for _, o := range r.Overrides {
+		if !o.matches(options) {
+			continue
+		}
+		if o.Price.Amount > 0 {
+			amount = o.Price.Amount
+		}
+		surcharge += o.Surcharge.Amount
+	}
+
+	if amount < 0 {
+		amount = int(math.Round(float64(cost.Amount)*(1+r.MarkupPercent/100))) + r.MarkupFixed.Amount + surcharge
+		if r.MinMarginPercent > 0 && r.MinMarginPercent < 100 {
+			floor := int(math.Ceil(float64(cost.Amount) / (1 - r.MinMarginPercent/100)))
+			amount = max(amount, floor)
+		}
+		amount = round(amount, r.Rounding)
+	}
+
+	capped := false
+	if limit, ok := r.Caps[currency]; ok && limit.Amount > 0 && amount > limit.Amount {
+		amount, capped = limit.Amount, true
+	}
+	return common.NewMoney(amount, currency), capped
+}
+
+// Reprice computes new prices for every variant of p that has a cost.
+//
+// The returned changes include only variants whose price differs from the current one.
+func (r Rules) Reprice(p product.Product) ProductChanges {
+	changes := ProductChanges{ProductId: p.Id, Title: p.Title, Variants: []VariantChange{}}
+	for _, v := range p.Variants {
+		if v.Cost.Amount <= 0 {
+			continue
+		}
+		price, capped := r.Price(v.Cost, p.OptionValues(v))
+		if price.Amount == v.Price.Amount {
+			continue
+		}
+		changes.Variants = append(changes.Variants, VariantChange{
+			VariantId:     v.Id,
+			Title:         v.Title,
+			Cost:          common.NewMoney(v.Cost.Amount, price.Currency),
+			OldPrice:      common.NewMoney(v.Price.Amount, price.Currency),
+			NewPrice:      price,
+			MarginPercent: margin(v.Cost.Amount, price.Amount),
+			Capped:        capped,
+		})
+	}
+	return changes
+}
+
+// Repricer applies Rules to every product in a shop.
+type Repricer struct {
+	Products product.Client
+	ShopId   int
+	Rules    Rules
+}
+
+// NewRepricer creates a Repricer.
+func NewRepricer(products product.Client, shopId int, rules Rules) *Repricer {
+	return &Repricer{Products: products, ShopId: shopId, Rules: rules}
+}
+
+// DryRun computes new prices for every product in the shop without changing anything.
+func (r *Repricer) DryRun() (*Report, error) {
+	products, err := r.Products.QueryProducts(r.ShopId, product.ListProductsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{Products: []ProductChanges{}}
+	for _, p := range products {
+		for _, v := range p.Variants {
+			if v.Cost.Amount > 0 {
+				report.Variants++
+			}
+		}
+		changes := r.Rules.Reprice(p)
+		if len(changes.Variants) > 0 {
+			report.Products = append(report.Products, changes)
+			report.Changed += len(changes.Variants)
+		}
+	}
+	return report, nil
+}
+
+// Apply updates the prices in report.
+//
+// Each product is updated with a PUT to the UpdateProduct endpoint whose body only
+// carries the changed variant prices (see product.PatchProduct), so nothing else
+// about the product is touched. Products that fail are reported and the rest are
+// still updated.
+func (r *Repricer) Apply(report *Report) []Failure {
+	var failures []Failure
+	for _, pc := range report.Products {
+		patch := product.ProductPatch{}
+		for _, vc := range pc.Variants {
+			price := vc.NewPrice
+			patch.Variants = append(patch.Variants, product.VariantPatch{Id: vc.VariantId, Price: &price})
+		}
+		if _, err := r.Products.PatchProduct(r.ShopId, pc.ProductId, patch); err != nil {
+			failures = append(failures, Failure{ProductId: pc.ProductId, Err: err})
+		}
+	}
+	return failures
+}
+
+func (o Override) matches(options map[string]string) bool {
+	for name, value := range options {
+		if o.Option != "" && !strings.EqualFold(o.Option, name) {
+			continue
+		}
+		if strings.EqualFold(strings.TrimSpace(o.Value), strings.TrimSpace(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+// round raises amount to the next price ending in the rounding's cents.
+func round(amount int, rounding RoundingEnum) int {
+	var cents int
+	switch rounding {
+	case Round99:
+		cents = 99
+	case Round95:
+		cents = 95
+	default:
+		return amount
+	}
+	rounded := amount - amount%100 + cents
+	if rounded < amount {
+		rounded += 100
+	}
+	return rounded
+}
+
+func margin(cost, price int) float64 {
+	if price == 0 {
+		return 0
+	}
+	return math.Round(float64(price-cost)/float64(price)*1000) / 10
+}
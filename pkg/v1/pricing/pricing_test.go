@@ -0,0 +1,87 @@
+package pricing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+	v1common "github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+)
+
+func newPricingTestClient(register func(mux *http.ServeMux)) (*common.Client, func()) {
+	mux := http.NewServeMux()
+	register(mux)
+	srv := httptest.NewServer(mux)
+	c := common.NewClient("printify_pat", 123)
+	c.Host = srv.URL
+	return c, srv.Close
+}
+
+var exampleRules = Rules{
+	MarkupPercent:    60,
+	MinMarginPercent: 35,
+	Rounding:         Round99,
+	Overrides: []Override{
+		{Option: "Sizes", Value: "2XL", Surcharge: v1common.NewMoney(300, "")},
+		{Option: "Colors", Value: "Gold", Price: v1common.NewMoney(4500, "")},
+	},
+	Caps:     map[string]v1common.Money{"USD": v1common.NewMoney(3500, "USD")},
+	Currency: "USD",
+}
+
+func ExampleRules_Price() {
+	for _, tc := range []struct {
+		cost    int
+		options map[string]string
+	}{
+		{1200, map[string]string{"Sizes": "M"}},
+		{1200, map[string]string{"Sizes": "2xl"}},
+		{2400, map[string]string{"Sizes": "M"}},
+		{1200, map[string]string{"Colors": "Gold"}},
+	} {
+		price, capped := exampleRules.Price(v1common.NewMoney(tc.cost, ""), tc.options)
+		fmt.Println(price, capped)
+	}
+	// Output:
+	// 19.99 USD false
+	// 22.99 USD false
+	// 35.00 USD true
+	// 35.00 USD true
+}
+
+func ExampleRepricer() {
+	c, closeFn := newPricingTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/products.json", func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page") == "2" {
+				_, _ = w.Write([]byte(`{"current_page":2,"last_page":2,"data":[{"id":"prod_2","title":"Plain Tee",
+"variants":[{"id":17887,"title":"M","price":1999,"cost":1200}]}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"current_page":1,"last_page":2,"data":[{"id":"prod_1","title":"Logo Tee",
+"options":[{"name":"Sizes","type":"size","values":[{"id":14,"title":"M"},{"id":16,"title":"2XL"}]}],
+"variants":[
+{"id":17887,"title":"M","price":1999,"cost":1200,"options":[14]},
+{"id":17888,"title":"2XL","price":1999,"cost":1350,"options":[16]}]}]}`))
+		})
+		mux.HandleFunc("/v1/shops/123/products/prod_1.json", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			fmt.Println(r.Method, string(body))
+			_, _ = w.Write([]byte(`{"id":"prod_1"}`))
+		})
+	})
+	defer closeFn()
+
+	r := NewRepricer(product.NewClient(c), 123, exampleRules)
+	report, _ := r.DryRun()
+	out, _ := json.Marshal(report)
+	fmt.Println(string(out))
+	fmt.Println("failures:", len(r.Apply(report)))
+	// Output:
+	// {"products":[{"product_id":"prod_1","title":"Logo Tee","variants":[{"variant_id":17888,"title":"2XL","cost":1350,"old_price":1999,"new_price":2499,"margin_percent":46,"capped":false}]}],"variants":3,"changed":1}
+	// PUT {"variants":[{"id":17888,"price":2499}]}
+	// failures: 0
+}
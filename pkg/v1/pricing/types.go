@@ -0,0 +1,86 @@
+package pricing
+
+import (
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+)
+
+// RoundingEnum selects how computed prices are rounded.
+type RoundingEnum string
+
+const (
+	// RoundNone keeps the computed price.
+	RoundNone RoundingEnum = ""
+	// Round99 rounds up to the next price ending in .99.
+	Round99 RoundingEnum = ".99"
+	// Round95 rounds up to the next price ending in .95.
+	Round95 RoundingEnum = ".95"
+)
+
+// Rules computes a variant's price from its cost.
+//
+// The price is Cost plus MarkupPercent, plus MarkupFixed and any matching
+// Override surcharges. It is then raised to honor MinMarginPercent, rounded,
+// and finally limited by the cap for its currency.
+type Rules struct {
+	// MarkupPercent is added to the cost, for example 40 for cost * 1.4.
+	MarkupPercent float64
+	// MarkupFixed is added after the percentage markup.
+	MarkupFixed common.Money
+	// MinMarginPercent is the smallest acceptable (price - cost) / price, for example 30.
+	MinMarginPercent float64
+	Rounding         RoundingEnum
+	// Overrides adjust variants with particular option values, for example a 2XL surcharge.
+	Overrides []Override
+	// Caps limits prices by currency code. Capped prices may not meet MinMarginPercent.
+	Caps map[string]common.Money
+	// Currency is assumed for costs reported without one.
+	Currency string
+}
+
+// Override adjusts the price of variants with a given option value.
+type Override struct {
+	// Option optionally restricts the match to one option, for example "Sizes".
+	Option string
+	// Value is the option value title, for example "2XL". Matching ignores case.
+	Value string
+	// Surcharge is added to the marked-up price.
+	Surcharge common.Money
+	// Price, when set, replaces the computed price. Rounding is not applied to it.
+	Price common.Money
+}
+
+// VariantChange is the repricing of one variant.
+type VariantChange struct {
+	VariantId int          `json:"variant_id"`
+	Title     string       `json:"title"`
+	Cost      common.Money `json:"cost"`
+	OldPrice  common.Money `json:"old_price"`
+	NewPrice  common.Money `json:"new_price"`
+	// MarginPercent is the margin at NewPrice.
+	MarginPercent float64 `json:"margin_percent"`
+	// Capped is true when NewPrice was limited by a cap.
+	Capped bool `json:"capped"`
+}
+
+// ProductChanges lists the variants of one product whose price changes.
+type ProductChanges struct {
+	ProductId string          `json:"product_id"`
+	Title     string          `json:"title"`
+	Variants  []VariantChange `json:"variants"`
+}
+
+// Report is the result of a dry run.
+type Report struct {
+	// Products lists only products with at least one price change.
+	Products []ProductChanges `json:"products"`
+	// Variants is the number of variants priced.
+	Variants int `json:"variants"`
+	// Changed is the number of variants whose price changes.
+	Changed int `json:"changed"`
+}
+
+// Failure is a product that could not be updated.
+type Failure struct {
+	ProductId string
+	Err       error
+}
@@ -0,0 +1,236 @@
+package product
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/connellrobert/printify-go/pkg/v1/catalog"
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+)
+
+// Builder assembles a Product ready for CreateProduct from a catalog blueprint and print provider.
+//
+// Setters can be chained; problems found along the way are reported by Build.
+type Builder struct {
+	product  Product
+	variants []catalog.Variant
+	selected map[int]bool
+	price    common.Money
+	prices   map[int]common.Money
+	images   map[string][]Image
+	order    []string
+	errs     []error
+}
+
+// NewBuilder fetches the variants offered by printProviderId for blueprintId and
+// returns a Builder with none of them selected.
+func NewBuilder(c catalog.Client, blueprintId int, printProviderId int) (*Builder, error) {
+	variants, err := c.ListVariantsByBlueprintPrintProvider(blueprintId, printProviderId)
+	if err != nil {
+		return nil, err
+	}
+	return &Builder{
+		product:  Product{BlueprintId: blueprintId, PrintProviderId: printProviderId, Visible: true},
+		variants: variants,
+		selected: map[int]bool{},
+		prices:   map[int]common.Money{},
+		images:   map[string][]Image{},
+	}, nil
+}
+
+// Variants returns the catalog variants available to the builder.
+func (b *Builder) Variants() []catalog.Variant {
+	return b.variants
+}
+
+// Title sets the product title.
+func (b *Builder) Title(title string) *Builder {
+	b.product.Title = title
+	return b
+}
+
+// Description sets the product description.
+func (b *Builder) Description(description string) *Builder {
+	b.product.Description = description
+	return b
+}
+
+// Tags sets the product tags.
+func (b *Builder) Tags(tags ...string) *Builder {
+	b.product.Tags = tags
+	return b
+}
+
+// Select adds every variant matching one of colors and one of sizes, ignoring case.
+// An empty list matches any value.
+func (b *Builder) Select(colors []string, sizes []string) *Builder {
+	matched := 0
+	for _, v := range b.variants {
+		if matchesAny(v.Options.Color, colors) && matchesAny(v.Options.Size, sizes) {
+			b.selected[v.Id] = true
+			matched++
+		}
+	}
+	if matched == 0 {
+		b.errs = append(b.errs, fmt.Errorf("no variant matches colors %v and sizes %v", colors, sizes))
+	}
+	return b
+}
+
+// SelectIds adds variants by catalog variant id.
+func (b *Builder) SelectIds(ids ...int) *Builder {
+	for _, id := range ids {
+		if b.variant(id) == nil {
+			b.errs = append(b.errs, fmt.Errorf("variant %d is not offered for this blueprint and print provider", id))
+			continue
+		}
+		b.selected[id] = true
+	}
+	return b
+}
+
+// Price sets the retail price of every selected variant without its own price.
+func (b *Builder) Price(price common.Money) *Builder {
+	b.price = price
+	return b
+}
+
+// VariantPrice sets the retail price of one variant.
+func (b *Builder) VariantPrice(id int, price common.Money) *Builder {
+	b.prices[id] = price
+	return b
+}
+
+// SizePrice sets the retail price of every variant of the given size, for example
+// a higher price for 2XL and up.
+func (b *Builder) SizePrice(size string, price common.Money) *Builder {
+	for _, v := range b.variants {
+		if strings.EqualFold(v.Options.Size, size) {
+			b.prices[v.Id] = price
+		}
+	}
+	return b
+}
+
+// Image places an uploaded image, centered at its original scale, on the placeholder
+// at position, for example "front".
+func (b *Builder) Image(position string, imageId string) *Builder {
+	return b.PlaceImage(position, Image{Id: imageId, X: 0.5, Y: 0.5, Scale: 1})
+}
+
+// PlaceImage adds an image with explicit placement to the placeholder at position.
+// Images are layered in the order they are added.
+func (b *Builder) PlaceImage(position string, img Image) *Builder {
+	if _, ok := b.images[position]; !ok {
+		b.order = append(b.order, position)
+	}
+	b.images[position] = append(b.images[position], img)
+	return b
+}
+
+// Build validates the selection and returns the Product.
+//
+// Every problem is reported at once. Selected variants become enabled product
+// variants, the first of them the default. Variants are grouped into print areas
+// by the placeholders their catalog entry offers; attaching an image to a position
+// no selected variant offers, or selecting a variant without any of the attached
+// positions, is an error.
+func (b *Builder) Build() (*Product, error) {
+	errs := append([]error(nil), b.errs...)
+	if strings.TrimSpace(b.product.Title) == "" {
+		errs = append(errs, errors.New("title is required"))
+	}
+	if len(b.images) == 0 {
+		errs = append(errs, errors.New("at least one image is required"))
+	}
+
+	p := b.product
+	p.Variants = nil
+	groups := map[string][]int{}
+	var keys []string
+	offered := map[string]bool{}
+	for _, v := range b.variants {
+		if !b.selected[v.Id] {
+			continue
+		}
+		price, ok := b.prices[v.Id]
+		if !ok {
+			price = b.price
+		}
+		if price.Amount <= 0 {
+			errs = append(errs, fmt.Errorf("variant %d (%s) has no price", v.Id, v.Title))
+		}
+		p.Variants = append(p.Variants, Variant{Id: v.Id, Price: price, IsEnabled: true, IsDefault: len(p.Variants) == 0})
+
+		var positions []string
+		for _, ph := range v.Placeholders {
+			offered[ph.Position] = true
+			if _, ok := b.images[ph.Position]; ok {
+				positions = append(positions, ph.Position)
+			}
+		}
+		if len(positions) == 0 && len(b.images) > 0 {
+			errs = append(errs, fmt.Errorf("variant %d (%s) has no placeholder for the attached images", v.Id, v.Title))
+		}
+		sort.Strings(positions)
+		key := strings.Join(positions, ",")
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], v.Id)
+	}
+	if len(p.Variants) == 0 {
+		errs = append(errs, errors.New("no variants selected"))
+	}
+	for _, position := range b.order {
+		if len(p.Variants) > 0 && !offered[position] {
+			errs = append(errs, fmt.Errorf("no selected variant has a %q placeholder", position))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	for _, key := range keys {
+		area := PrintArea{VariantIds: groups[key]}
+		for _, position := range b.order {
+			if containsString(strings.Split(key, ","), position) {
+				area.Placeholders = append(area.Placeholders, Placeholder{Position: position, Images: b.images[position]})
+			}
+		}
+		p.PrintAreas = append(p.PrintAreas, area)
+	}
+	return &p, nil
+}
+
+func (b *Builder) variant(id int) *catalog.Variant {
+	for i := range b.variants {
+		if b.variants[i].Id == id {
+			return &b.variants[i]
+		}
+	}
+	return nil
+}
+
+func matchesAny(value string, candidates []string) bool {
+	if len(candidates) == 0 {
+		return true
+	}
+	for _, c := range candidates {
+		if strings.EqualFold(strings.TrimSpace(c), strings.TrimSpace(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
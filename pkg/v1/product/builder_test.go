@@ -0,0 +1,77 @@
+package product
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/connellrobert/printify-go/pkg/v1/catalog"
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+)
+
+const builderVariants = `[
+{"id":1,"title":"Black / M","options":{"color":"Black","size":"M"},"placeholders":[{"position":"front","height":3995,"width":3555},{"position":"back","height":3995,"width":3555}]},
+{"id":2,"title":"Black / 2XL","options":{"color":"Black","size":"2XL"},"placeholders":[{"position":"front","height":3995,"width":3555},{"position":"back","height":3995,"width":3555}]},
+{"id":3,"title":"White / M","options":{"color":"White","size":"M"},"placeholders":[{"position":"front","height":3995,"width":3555}]},
+{"id":4,"title":"Navy / M","options":{"color":"Navy","size":"M"},"placeholders":[{"position":"front","height":3995,"width":3555}]}
+]`
+
+func newBuilderTestCatalog() (catalog.Client, func()) {
+	c, closeFn := newProductTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/catalog/blueprints/6/print_providers/99/variants.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(builderVariants))
+		})
+	})
+	return catalog.NewClient(c), closeFn
+}
+
+func ExampleBuilder_Build() {
+	cat, closeFn := newBuilderTestCatalog()
+	defer closeFn()
+
+	b, _ := NewBuilder(cat, 6, 99)
+	p, err := b.Title("Logo Tee").
+		Select([]string{"black", "white"}, nil).
+		Price(common.NewMoney(2500, "USD")).
+		SizePrice("2XL", common.NewMoney(2900, "USD")).
+		Image("front", "img_logo").
+		Image("back", "img_back").
+		Build()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, v := range p.Variants {
+		fmt.Println(v.Id, v.Price, v.IsEnabled, v.IsDefault)
+	}
+	for _, area := range p.PrintAreas {
+		for _, ph := range area.Placeholders {
+			fmt.Println(area.VariantIds, ph.Position, ph.Images[0].Id)
+		}
+	}
+	// Output:
+	// 1 25.00 USD true true
+	// 2 29.00 USD true false
+	// 3 25.00 USD true false
+	// [1 2] front img_logo
+	// [1 2] back img_back
+	// [3] front img_logo
+}
+
+func ExampleBuilder_Build_invalid() {
+	cat, closeFn := newBuilderTestCatalog()
+	defer closeFn()
+
+	b, _ := NewBuilder(cat, 6, 99)
+	_, err := b.Select([]string{"red"}, nil).
+		SelectIds(4, 42).
+		Image("sleeve", "img_logo").
+		Build()
+	fmt.Println(err)
+	// Output:
+	// no variant matches colors [red] and sizes []
+	// variant 42 is not offered for this blueprint and print provider
+	// title is required
+	// variant 4 (Navy / M) has no price
+	// variant 4 (Navy / M) has no placeholder for the attached images
+	// no selected variant has a "sleeve" placeholder
+}
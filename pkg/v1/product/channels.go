@@ -0,0 +1,265 @@
+package product
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SalesChannelEnum identifies the sales channel a shop is connected to, as reported by
+// shop.Shop.SalesChannel.
+type SalesChannelEnum string
+
+const (
+	SalesChannelShopify     SalesChannelEnum = "shopify"
+	SalesChannelEtsy        SalesChannelEnum = "etsy"
+	SalesChannelEbay        SalesChannelEnum = "ebay"
+	SalesChannelWooCommerce SalesChannelEnum = "woocommerce"
+	// SalesChannelCustom is a custom API integration. Shops report it as "custom_integration" or "api".
+	SalesChannelCustom SalesChannelEnum = "custom_integration"
+)
+
+// ParseSalesChannel normalizes a shop's sales channel name.
+func ParseSalesChannel(name string) SalesChannelEnum {
+	switch c := SalesChannelEnum(strings.ToLower(strings.TrimSpace(name))); c {
+	case "api", "custom":
+		return SalesChannelCustom
+	default:
+		return c
+	}
+}
+
+// SalesChannelProperties is one entry of Product.SalesChannelProperties.
+//
+// The typed properties keep the received keys that their fields would not encode in
+// Extra and encode them again: keys without a field, and keys whose field is empty,
+// such as "shipping_profile_id":0 or "vendor":"". Decoding and setting an entry
+// therefore keeps every received key. Unset booleans are nil and are left out of the JSON.
+type SalesChannelProperties interface {
+	SalesChannel() SalesChannelEnum
+}
+
+// ShopifyProperties are the product properties of a Shopify store.
+type ShopifyProperties struct {
+	ProductType    string   `json:"product_type,omitempty"`
+	Vendor         string   `json:"vendor,omitempty"`
+	Collections    []string `json:"collections,omitempty"`
+	SeoTitle       string   `json:"seo_title,omitempty"`
+	SeoDescription string   `json:"seo_description,omitempty"`
+	// Extra holds the received keys without a field above or with an empty value.
+	Extra map[string]interface{} `json:"-"`
+}
+
+func (ShopifyProperties) SalesChannel() SalesChannelEnum { return SalesChannelShopify }
+
+func (s ShopifyProperties) MarshalJSON() ([]byte, error) {
+	type plain ShopifyProperties
+	return marshalWithExtra(plain(s), s.Extra)
+}
+
+func (s *ShopifyProperties) UnmarshalJSON(data []byte) error {
+	type plain ShopifyProperties
+	return unmarshalWithExtra(data, (*plain)(s), &s.Extra)
+}
+
+// EtsyProperties are the listing properties of an Etsy shop.
+type EtsyProperties struct {
+	// WhoMade is "i_did", "someone_else" or "collective".
+	WhoMade string `json:"who_made,omitempty"`
+	// WhenMade is "made_to_order" or a range such as "2020_2024".
+	WhenMade          string   `json:"when_made,omitempty"`
+	IsSupply          *bool    `json:"is_supply,omitempty"`
+	ShippingProfileId int      `json:"shipping_profile_id,omitempty"`
+	TaxonomyId        int      `json:"taxonomy_id,omitempty"`
+	Materials         []string `json:"materials,omitempty"`
+	IsPersonalizable  *bool    `json:"is_personalizable,omitempty"`
+	// Extra holds the received keys without a field above or with an empty value.
+	Extra map[string]interface{} `json:"-"`
+}
+
+func (EtsyProperties) SalesChannel() SalesChannelEnum { return SalesChannelEtsy }
+
+func (e EtsyProperties) MarshalJSON() ([]byte, error) {
+	type plain EtsyProperties
+	return marshalWithExtra(plain(e), e.Extra)
+}
+
+func (e *EtsyProperties) UnmarshalJSON(data []byte) error {
+	type plain EtsyProperties
+	return unmarshalWithExtra(data, (*plain)(e), &e.Extra)
+}
+
+// EbayProperties are the listing properties of an eBay store.
+type EbayProperties struct {
+	CategoryId      string `json:"category_id,omitempty"`
+	Condition       string `json:"condition,omitempty"`
+	ListingDuration string `json:"listing_duration,omitempty"`
+	// Extra holds the received keys without a field above or with an empty value.
+	Extra map[string]interface{} `json:"-"`
+}
+
+func (EbayProperties) SalesChannel() SalesChannelEnum { return SalesChannelEbay }
+
+func (e EbayProperties) MarshalJSON() ([]byte, error) {
+	type plain EbayProperties
+	return marshalWithExtra(plain(e), e.Extra)
+}
+
+func (e *EbayProperties) UnmarshalJSON(data []byte) error {
+	type plain EbayProperties
+	return unmarshalWithExtra(data, (*plain)(e), &e.Extra)
+}
+
+// WooCommerceProperties are the product properties of a WooCommerce store.
+type WooCommerceProperties struct {
+	Categories []string `json:"categories,omitempty"`
+	// Status is the WooCommerce post status, for example "publish" or "draft".
+	Status   string `json:"status,omitempty"`
+	Featured *bool  `json:"featured,omitempty"`
+	// Extra holds the received keys without a field above or with an empty value.
+	Extra map[string]interface{} `json:"-"`
+}
+
+func (WooCommerceProperties) SalesChannel() SalesChannelEnum { return SalesChannelWooCommerce }
+
+func (w WooCommerceProperties) MarshalJSON() ([]byte, error) {
+	type plain WooCommerceProperties
+	return marshalWithExtra(plain(w), w.Extra)
+}
+
+func (w *WooCommerceProperties) UnmarshalJSON(data []byte) error {
+	type plain WooCommerceProperties
+	return unmarshalWithExtra(data, (*plain)(w), &w.Extra)
+}
+
+// CustomProperties are the free-form properties of a custom API integration.
+type CustomProperties map[string]interface{}
+
+func (CustomProperties) SalesChannel() SalesChannelEnum { return SalesChannelCustom }
+
+// RawProperties keep an entry of a channel without typed properties as it was received.
+type RawProperties struct {
+	Channel SalesChannelEnum
+	JSON    json.RawMessage
+}
+
+func (r RawProperties) SalesChannel() SalesChannelEnum { return r.Channel }
+
+func (r RawProperties) MarshalJSON() ([]byte, error) {
+	if len(r.JSON) == 0 {
+		return []byte("null"), nil
+	}
+	return r.JSON, nil
+}
+
+// DecodeSalesChannelProperties decodes Product.SalesChannelProperties for a shop on
+// the given sales channel. Entries of unknown channels are kept as RawProperties.
+func DecodeSalesChannelProperties(channel string, raw []interface{}) ([]SalesChannelProperties, error) {
+	c := ParseSalesChannel(channel)
+	out := make([]SalesChannelProperties, 0, len(raw))
+	for i, entry := range raw {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return nil, fmt.Errorf("sales_channel_properties[%d]: %w", i, err)
+		}
+		var props SalesChannelProperties
+		switch c {
+		case SalesChannelShopify:
+			props, err = decodeProperties[ShopifyProperties](data)
+		case SalesChannelEtsy:
+			props, err = decodeProperties[EtsyProperties](data)
+		case SalesChannelEbay:
+			props, err = decodeProperties[EbayProperties](data)
+		case SalesChannelWooCommerce:
+			props, err = decodeProperties[WooCommerceProperties](data)
+		case SalesChannelCustom:
+			props, err = decodeProperties[CustomProperties](data)
+		default:
+			props = RawProperties{Channel: c, JSON: data}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("sales_channel_properties[%d]: %w", i, err)
+		}
+		out = append(out, props)
+	}
+	return out, nil
+}
+
+// TypedSalesChannelProperties decodes p.SalesChannelProperties for a shop on the given
+// sales channel. See DecodeSalesChannelProperties.
+func (p Product) TypedSalesChannelProperties(channel string) ([]SalesChannelProperties, error) {
+	return DecodeSalesChannelProperties(channel, p.SalesChannelProperties)
+}
+
+// SetSalesChannelProperties replaces p.SalesChannelProperties with props, encoded to
+// the same JSON the API returns.
+func (p *Product) SetSalesChannelProperties(props ...SalesChannelProperties) error {
+	raw := make([]interface{}, 0, len(props))
+	for i, prop := range props {
+		data, err := json.Marshal(prop)
+		if err != nil {
+			return fmt.Errorf("sales_channel_properties[%d]: %w", i, err)
+		}
+		var entry interface{}
+		if err := json.Unmarshal(data, &entry); err != nil {
+			return fmt.Errorf("sales_channel_properties[%d]: %w", i, err)
+		}
+		raw = append(raw, entry)
+	}
+	p.SalesChannelProperties = raw
+	return nil
+}
+
+func decodeProperties[T SalesChannelProperties](data []byte) (SalesChannelProperties, error) {
+	var props T
+	if err := json.Unmarshal(data, &props); err != nil {
+		return nil, err
+	}
+	return props, nil
+}
+
+// unmarshalWithExtra decodes data into v and the keys that v does not encode again,
+// either unknown or empty, into extra.
+func unmarshalWithExtra(data []byte, v interface{}, extra *map[string]interface{}) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	var all map[string]interface{}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	typed, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var encoded map[string]json.RawMessage
+	if err := json.Unmarshal(typed, &encoded); err != nil {
+		return err
+	}
+	for key := range encoded {
+		delete(all, key)
+	}
+	if len(all) == 0 {
+		all = nil
+	}
+	*extra = all
+	return nil
+}
+
+// marshalWithExtra encodes v with the keys of extra that v does not encode.
+func marshalWithExtra(v interface{}, extra map[string]interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+	var all map[string]interface{}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	for key, value := range extra {
+		if _, ok := all[key]; !ok {
+			all[key] = value
+		}
+	}
+	return json.Marshal(all)
+}
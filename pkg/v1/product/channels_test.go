@@ -0,0 +1,50 @@
+package product
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func ExampleProduct_TypedSalesChannelProperties() {
+	var p Product
+	_ = json.Unmarshal([]byte(`{"id":"prod_1","sales_channel_properties":[{"who_made":"i_did","when_made":"made_to_order","is_supply":false,"shipping_profile_id":42}]}`), &p)
+
+	props, _ := p.TypedSalesChannelProperties("etsy")
+	if etsy, ok := props[0].(EtsyProperties); ok {
+		fmt.Println(etsy.SalesChannel(), etsy.WhoMade, etsy.ShippingProfileId)
+	}
+
+	props, _ = p.TypedSalesChannelProperties("tiktok")
+	raw := props[0].(RawProperties)
+	fmt.Println(raw.SalesChannel(), string(raw.JSON))
+	// Output:
+	// etsy i_did 42
+	// tiktok {"is_supply":false,"shipping_profile_id":42,"when_made":"made_to_order","who_made":"i_did"}
+}
+
+func ExampleProduct_SetSalesChannelProperties() {
+	var p Product
+	_ = p.SetSalesChannelProperties(ShopifyProperties{ProductType: "T-Shirt", Vendor: "Acme", Collections: []string{"Summer"}})
+	data, _ := json.Marshal(p.SalesChannelProperties)
+	fmt.Println(string(data))
+
+	props, _ := DecodeSalesChannelProperties("api", []interface{}{map[string]interface{}{"ref": "abc"}})
+	fmt.Println(props[0].SalesChannel(), props[0].(CustomProperties)["ref"])
+	// Output:
+	// [{"collections":["Summer"],"product_type":"T-Shirt","vendor":"Acme"}]
+	// custom_integration abc
+}
+
+func ExampleProduct_SetSalesChannelProperties_roundTrip() {
+	var p Product
+	_ = json.Unmarshal([]byte(`{"id":"prod_1","sales_channel_properties":[{"who_made":"i_did","is_supply":true,"renewal":"auto","shipping_profile_id":0,"materials":[]}]}`), &p)
+
+	props, _ := p.TypedSalesChannelProperties("etsy")
+	etsy := props[0].(EtsyProperties)
+	etsy.WhenMade = "made_to_order"
+	_ = p.SetSalesChannelProperties(etsy)
+	data, _ := json.Marshal(p.SalesChannelProperties)
+	fmt.Println(string(data))
+	// Output:
+	// [{"is_supply":true,"materials":[],"renewal":"auto","shipping_profile_id":0,"when_made":"made_to_order","who_made":"i_did"}]
+}
@@ -9,9 +9,11 @@ import (
 // Client defines product operations and enables dependency injection.
 type Client interface {
 	ListProducts(id int) ([]Product, error)
+	QueryProducts(id int, opts ListProductsOptions) ([]Product, error)
 	GetProduct(idOne int, idTwo string) (*Product, error)
 	CreateProduct(id int, body Product) (*Product, error)
 	UpdateProduct(idOne int, idTwo string, body Product) (*Product, error)
+	PatchProduct(idOne int, idTwo string, body ProductPatch) (*Product, error)
 	DeleteProduct(idOne int, idTwo string) error
 	PublishProduct(idOne int, idTwo string, body Publish) error
 	UpdatePublishStatusToSucceeded(idOne int, idTwo string, body PublishReference) error
@@ -32,6 +34,10 @@ func (cl *client) ListProducts(id int) ([]Product, error) {
 	return ListProducts(cl.c, id)
 }
 
+func (cl *client) QueryProducts(id int, opts ListProductsOptions) ([]Product, error) {
+	return QueryProducts(cl.c, id, opts)
+}
+
 func (cl *client) GetProduct(idOne int, idTwo string) (*Product, error) {
 	return GetProduct(cl.c, idOne, idTwo)
 }
@@ -44,6 +50,10 @@ func (cl *client) UpdateProduct(idOne int, idTwo string, body Product) (*Product
 	return UpdateProduct(cl.c, idOne, idTwo, body)
 }
 
+func (cl *client) PatchProduct(idOne int, idTwo string, body ProductPatch) (*Product, error) {
+	return PatchProduct(cl.c, idOne, idTwo, body)
+}
+
 func (cl *client) DeleteProduct(idOne int, idTwo string) error {
 	return DeleteProduct(cl.c, idOne, idTwo)
 }
@@ -120,6 +130,18 @@ var (
 	// shopId can be discovered with shop.ListShops.
 	// productId can be discovered with ListProducts(idOne).
 	UpdateProduct = common.PutResourceWithReturnAndTwoId[Product, Product, int, string](UPDATE_PRODUCT_ENDPOINT)
+	// PatchProduct calls PUT /v1/shops/{shopId}/products/{productId}.json with only the fields set in body.
+	//
+	// Signature:
+	//	func(c *common.Client, idOne int, idTwo string, body ProductPatch) (*Product, error)
+	// Parameter mapping:
+	//	idOne -> {shopId}
+	//	idTwo -> {productId}
+	//	body -> partial product payload, usually built with Diff
+	//
+	// shopId can be discovered with shop.ListShops.
+	// productId can be discovered with ListProducts(idOne).
+	PatchProduct = common.PutResourceWithReturnAndTwoId[ProductPatch, Product, int, string](UPDATE_PRODUCT_ENDPOINT)
 	// DeleteProduct calls DELETE /v1/shops/{shopId}/products/{productId}.json.
 	//
 	// Signature:
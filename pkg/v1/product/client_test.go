@@ -34,7 +34,7 @@ func ExampleListProducts() {
 
 	items, _ := ListProducts(c, 123)
 	fmt.Printf("%#v\n", items[0])
-	// Output: product.Product{Id:"prod_1", Title:"T-Shirt", Description:"", Tags:[]string(nil), Options:[]product.ProductOptions(nil), Variants:[]product.Variant(nil), Images:[]product.MockupImage(nil), CreatedAt:"", UpdateAt:"", Visible:false, BlueprintId:0, PrintProviderId:0, UserId:0, ShopId:0, PrintAreas:[]product.PrintArea(nil), PrintDetails:[]common.PrintDetails(nil), External:product.PublishReference{Id:"", Handle:"", ShippingTemplateId:""}, IsLocked:false, IsPrintifyExpressEligible:false, IsEconomyShippingEligible:false, IsPrintifyExpressEnabled:false, IsEconomyShippingEnabled:false, SalesChannelProperties:[]interface {}(nil)}
+	// Output: product.Product{Id:"prod_1", Title:"T-Shirt", Description:"", Tags:[]string(nil), Options:[]product.ProductOptions(nil), Variants:[]product.Variant(nil), Images:[]product.MockupImage(nil), CreatedAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), UpdateAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), Visible:false, BlueprintId:0, PrintProviderId:0, UserId:0, ShopId:0, PrintAreas:[]product.PrintArea(nil), PrintDetails:[]common.PrintDetails(nil), External:product.PublishReference{Id:"", Handle:"", ShippingTemplateId:""}, IsLocked:false, IsPrintifyExpressEligible:false, IsEconomyShippingEligible:false, IsPrintifyExpressEnabled:false, IsEconomyShippingEnabled:false, SalesChannelProperties:[]interface {}(nil)}
 }
 
 func ExampleGetProduct() {
@@ -47,7 +47,7 @@ func ExampleGetProduct() {
 
 	item, _ := GetProduct(c, 123, "5f2e9a3b7c1d4e8f90ab12cd")
 	fmt.Printf("%#v\n", *item)
-	// Output: product.Product{Id:"5f2e9a3b7c1d4e8f90ab12cd", Title:"Hoodie", Description:"", Tags:[]string(nil), Options:[]product.ProductOptions(nil), Variants:[]product.Variant(nil), Images:[]product.MockupImage(nil), CreatedAt:"", UpdateAt:"", Visible:false, BlueprintId:0, PrintProviderId:0, UserId:0, ShopId:0, PrintAreas:[]product.PrintArea(nil), PrintDetails:[]common.PrintDetails(nil), External:product.PublishReference{Id:"", Handle:"", ShippingTemplateId:""}, IsLocked:false, IsPrintifyExpressEligible:false, IsEconomyShippingEligible:false, IsPrintifyExpressEnabled:false, IsEconomyShippingEnabled:false, SalesChannelProperties:[]interface {}(nil)}
+	// Output: product.Product{Id:"5f2e9a3b7c1d4e8f90ab12cd", Title:"Hoodie", Description:"", Tags:[]string(nil), Options:[]product.ProductOptions(nil), Variants:[]product.Variant(nil), Images:[]product.MockupImage(nil), CreatedAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), UpdateAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), Visible:false, BlueprintId:0, PrintProviderId:0, UserId:0, ShopId:0, PrintAreas:[]product.PrintArea(nil), PrintDetails:[]common.PrintDetails(nil), External:product.PublishReference{Id:"", Handle:"", ShippingTemplateId:""}, IsLocked:false, IsPrintifyExpressEligible:false, IsEconomyShippingEligible:false, IsPrintifyExpressEnabled:false, IsEconomyShippingEnabled:false, SalesChannelProperties:[]interface {}(nil)}
 }
 
 func ExampleCreateProduct() {
@@ -60,7 +60,7 @@ func ExampleCreateProduct() {
 
 	item, _ := CreateProduct(c, 123, Product{})
 	fmt.Printf("%#v\n", *item)
-	// Output: product.Product{Id:"prod_new", Title:"Poster", Description:"", Tags:[]string(nil), Options:[]product.ProductOptions(nil), Variants:[]product.Variant(nil), Images:[]product.MockupImage(nil), CreatedAt:"", UpdateAt:"", Visible:false, BlueprintId:0, PrintProviderId:0, UserId:0, ShopId:0, PrintAreas:[]product.PrintArea(nil), PrintDetails:[]common.PrintDetails(nil), External:product.PublishReference{Id:"", Handle:"", ShippingTemplateId:""}, IsLocked:false, IsPrintifyExpressEligible:false, IsEconomyShippingEligible:false, IsPrintifyExpressEnabled:false, IsEconomyShippingEnabled:false, SalesChannelProperties:[]interface {}(nil)}
+	// Output: product.Product{Id:"prod_new", Title:"Poster", Description:"", Tags:[]string(nil), Options:[]product.ProductOptions(nil), Variants:[]product.Variant(nil), Images:[]product.MockupImage(nil), CreatedAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), UpdateAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), Visible:false, BlueprintId:0, PrintProviderId:0, UserId:0, ShopId:0, PrintAreas:[]product.PrintArea(nil), PrintDetails:[]common.PrintDetails(nil), External:product.PublishReference{Id:"", Handle:"", ShippingTemplateId:""}, IsLocked:false, IsPrintifyExpressEligible:false, IsEconomyShippingEligible:false, IsPrintifyExpressEnabled:false, IsEconomyShippingEnabled:false, SalesChannelProperties:[]interface {}(nil)}
 }
 
 func ExampleUpdateProduct() {
@@ -73,7 +73,7 @@ func ExampleUpdateProduct() {
 
 	item, _ := UpdateProduct(c, 123, "5f2e9a3b7c1d4e8f90ab12cd", Product{})
 	fmt.Printf("%#v\n", *item)
-	// Output: product.Product{Id:"5f2e9a3b7c1d4e8f90ab12cd", Title:"Updated Hoodie", Description:"", Tags:[]string(nil), Options:[]product.ProductOptions(nil), Variants:[]product.Variant(nil), Images:[]product.MockupImage(nil), CreatedAt:"", UpdateAt:"", Visible:false, BlueprintId:0, PrintProviderId:0, UserId:0, ShopId:0, PrintAreas:[]product.PrintArea(nil), PrintDetails:[]common.PrintDetails(nil), External:product.PublishReference{Id:"", Handle:"", ShippingTemplateId:""}, IsLocked:false, IsPrintifyExpressEligible:false, IsEconomyShippingEligible:false, IsPrintifyExpressEnabled:false, IsEconomyShippingEnabled:false, SalesChannelProperties:[]interface {}(nil)}
+	// Output: product.Product{Id:"5f2e9a3b7c1d4e8f90ab12cd", Title:"Updated Hoodie", Description:"", Tags:[]string(nil), Options:[]product.ProductOptions(nil), Variants:[]product.Variant(nil), Images:[]product.MockupImage(nil), CreatedAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), UpdateAt:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), Visible:false, BlueprintId:0, PrintProviderId:0, UserId:0, ShopId:0, PrintAreas:[]product.PrintArea(nil), PrintDetails:[]common.PrintDetails(nil), External:product.PublishReference{Id:"", Handle:"", ShippingTemplateId:""}, IsLocked:false, IsPrintifyExpressEligible:false, IsEconomyShippingEligible:false, IsPrintifyExpressEnabled:false, IsEconomyShippingEnabled:false, SalesChannelProperties:[]interface {}(nil)}
 }
 
 func ExampleDeleteProduct() {
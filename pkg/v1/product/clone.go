@@ -0,0 +1,190 @@
+package product
+
+import (
+	"fmt"
+
+	"github.com/connellrobert/printify-go/pkg/v1/catalog"
+)
+
+// Creatable returns a copy of p with only the fields CreateProduct accepts.
+//
+// Read only fields such as Id, ShopId, External, IsLocked, mockup Images and
+// timestamps are dropped, and variants keep only their id, SKU, price and flags.
+func (p Product) Creatable() Product {
+	out := Product{
+		Title:                    p.Title,
+		Description:              p.Description,
+		Tags:                     append([]string(nil), p.Tags...),
+		BlueprintId:              p.BlueprintId,
+		PrintProviderId:          p.PrintProviderId,
+		Visible:                  p.Visible,
+		PrintAreas:               make([]PrintArea, 0, len(p.PrintAreas)),
+		PrintDetails:             p.PrintDetails,
+		IsPrintifyExpressEnabled: p.IsPrintifyExpressEnabled,
+		IsEconomyShippingEnabled: p.IsEconomyShippingEnabled,
+	}
+	for _, v := range p.Variants {
+		out.Variants = append(out.Variants, Variant{Id: v.Id, Sku: v.Sku, Price: v.Price, IsEnabled: v.IsEnabled, IsDefault: v.IsDefault})
+	}
+	for _, area := range p.PrintAreas {
+		a := PrintArea{VariantIds: append([]int(nil), area.VariantIds...)}
+		for _, ph := range area.Placeholders {
+			a.Placeholders = append(a.Placeholders, Placeholder{Position: ph.Position, Images: append([]Image(nil), ph.Images...)})
+		}
+		out.PrintAreas = append(out.PrintAreas, a)
+	}
+	return out
+}
+
+// CloneOptions tunes Clone and CloneAll.
+type CloneOptions struct {
+	// PrintProviderId swaps the print provider of the copies. Zero keeps the source's provider.
+	PrintProviderId int
+	// Catalog looks up the new print provider's variants. Required with PrintProviderId.
+	Catalog catalog.Client
+	// Filter selects the products copied by CloneAll. Nil copies every product.
+	Filter func(p Product) bool
+}
+
+// CloneResult is the outcome of copying one product.
+type CloneResult struct {
+	SourceId string `json:"source_id"`
+	NewId    string `json:"new_id,omitempty"`
+	// DroppedVariants lists source variant ids the new print provider does not offer.
+	DroppedVariants []int `json:"dropped_variants,omitempty"`
+	Err             error `json:"-"`
+}
+
+// Clone copies a product from one shop to another with GetProduct and CreateProduct.
+//
+// src and dst may be the same client when both shops belong to the same account.
+// When opts.PrintProviderId is set, the provider must offer the product's blueprint;
+// variants are matched by id, then by title, and variants it does not offer are dropped.
+func Clone(src Client, srcShopId int, productId string, dst Client, dstShopId int, opts CloneOptions) CloneResult {
+	result := CloneResult{SourceId: productId}
+	p, err := src.GetProduct(srcShopId, productId)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	return clone(*p, dst, dstShopId, opts)
+}
+
+// CloneAll copies every product of a shop, across every page, accepted by opts.Filter
+// and reports the new id of each. The error is only set when the source products cannot be listed.
+func CloneAll(src Client, srcShopId int, dst Client, dstShopId int, opts CloneOptions) ([]CloneResult, error) {
+	products, err := src.QueryProducts(srcShopId, ListProductsOptions{Filter: opts.Filter})
+	if err != nil {
+		return nil, err
+	}
+	var results []CloneResult
+	for _, p := range products {
+		results = append(results, clone(p, dst, dstShopId, opts))
+	}
+	return results, nil
+}
+
+// CloneMapping maps source product ids to the ids of their copies, leaving out failures.
+func CloneMapping(results []CloneResult) map[string]string {
+	mapping := map[string]string{}
+	for _, r := range results {
+		if r.Err == nil && r.NewId != "" {
+			mapping[r.SourceId] = r.NewId
+		}
+	}
+	return mapping
+}
+
+func clone(p Product, dst Client, dstShopId int, opts CloneOptions) CloneResult {
+	result := CloneResult{SourceId: p.Id}
+	body := p.Creatable()
+	if opts.PrintProviderId != 0 && opts.PrintProviderId != p.PrintProviderId {
+		titles := map[int]string{}
+		for _, v := range p.Variants {
+			titles[v.Id] = v.Title
+		}
+		dropped, err := swapProvider(&body, titles, opts.Catalog, opts.PrintProviderId)
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		result.DroppedVariants = dropped
+	}
+	created, err := dst.CreateProduct(dstShopId, body)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.NewId = created.Id
+	return result
+}
+
+// swapProvider moves p to printProviderId, looking variants up by id and then by
+// their source title in titles, and returns the ids of the variants it dropped.
+func swapProvider(p *Product, titles map[int]string, c catalog.Client, printProviderId int) ([]int, error) {
+	if c == nil {
+		return nil, fmt.Errorf("a catalog client is required to swap print providers")
+	}
+	providers, err := c.ListPrintProvidersByBlueprint(p.BlueprintId)
+	if err != nil {
+		return nil, err
+	}
+	offered := false
+	for _, pp := range providers {
+		offered = offered || pp.Id == printProviderId
+	}
+	if !offered {
+		return nil, fmt.Errorf("print provider %d does not offer blueprint %d", printProviderId, p.BlueprintId)
+	}
+	variants, err := c.ListVariantsByBlueprintPrintProvider(p.BlueprintId, printProviderId)
+	if err != nil {
+		return nil, err
+	}
+	byId := map[int]bool{}
+	byTitle := map[string]int{}
+	for _, v := range variants {
+		byId[v.Id] = true
+		byTitle[v.Title] = v.Id
+	}
+
+	mapped := map[int]int{}
+	var kept []Variant
+	var dropped []int
+	for _, v := range p.Variants {
+		id, ok := v.Id, byId[v.Id]
+		if !ok {
+			id, ok = byTitle[titles[v.Id]]
+		}
+		if !ok {
+			dropped = append(dropped, v.Id)
+			continue
+		}
+		mapped[v.Id] = id
+		v.Id = id
+		kept = append(kept, v)
+	}
+	if len(kept) == 0 {
+		return nil, fmt.Errorf("print provider %d offers none of the product's variants", printProviderId)
+	}
+
+	areas := p.PrintAreas[:0]
+	for _, area := range p.PrintAreas {
+		var ids []int
+		for _, id := range area.VariantIds {
+			if newId, ok := mapped[id]; ok {
+				ids = append(ids, newId)
+			}
+		}
+		if len(ids) > 0 {
+			area.VariantIds = ids
+			areas = append(areas, area)
+		}
+	}
+	p.PrintProviderId = printProviderId
+	p.Variants = kept
+	p.PrintAreas = areas
+	if err := p.EnsureDefault(); err != nil {
+		return nil, err
+	}
+	return dropped, nil
+}
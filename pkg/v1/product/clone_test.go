@@ -0,0 +1,67 @@
+package product
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/connellrobert/printify-go/pkg/v1/catalog"
+)
+
+func ExampleClone() {
+	c, closeFn := newProductTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/products/prod_1.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"prod_1","shop_id":123,"title":"Tee","blueprint_id":6,"print_provider_id":99,"is_locked":true,
+"external":{"id":"ext_1","handle":"tee"},"images":[{"src":"https://images.example/mockup.png"}],
+"variants":[{"id":1,"title":"Black / M","price":2500,"cost":1100,"is_enabled":true,"is_default":true},{"id":7,"title":"Black / XL","price":2500,"is_enabled":true},{"id":8,"title":"Pink / XL","price":2500,"is_enabled":true}],
+"print_areas":[{"variant_ids":[1,7,8],"placeholders":[{"position":"front","images":[{"id":"img_1","x":0.5,"y":0.5,"scale":1}]}]}]}`))
+		})
+		mux.HandleFunc("/v1/catalog/blueprints/6/print_providers.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"data":[{"id":99,"title":"Provider A"},{"id":29,"title":"Provider B"}]}`))
+		})
+		mux.HandleFunc("/v1/catalog/blueprints/6/print_providers/29/variants.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[{"id":1,"title":"Black / M"},{"id":17,"title":"Black / XL"}]`))
+		})
+		mux.HandleFunc("/v1/shops/456/products.json", func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			fmt.Println("print provider:", body["print_provider_id"], "locked:", body["is_locked"], "external:", body["external"].(map[string]any)["id"] != "")
+			fmt.Println("variant ids:", body["print_areas"].([]any)[0].(map[string]any)["variant_ids"])
+			_, _ = w.Write([]byte(`{"id":"prod_9"}`))
+		})
+	})
+	defer closeFn()
+
+	products := NewClient(c)
+	result := Clone(products, 123, "prod_1", products, 456, CloneOptions{PrintProviderId: 29, Catalog: catalog.NewClient(c)})
+	fmt.Println(result.SourceId, "->", result.NewId, "dropped:", result.DroppedVariants, result.Err)
+	// Output:
+	// print provider: 29 locked: false external: false
+	// variant ids: [1 17]
+	// prod_1 -> prod_9 dropped: [8] <nil>
+}
+
+func ExampleCloneAll() {
+	c, closeFn := newProductTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/products.json", func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page") == "2" {
+				_, _ = w.Write([]byte(`{"current_page":2,"last_page":2,"data":[{"id":"prod_3","title":"Hoodie","tags":["summer"]}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"current_page":1,"last_page":2,"data":[{"id":"prod_1","title":"Tee","tags":["summer"]},{"id":"prod_2","title":"Mug"}]}`))
+		})
+		created := 0
+		mux.HandleFunc("/v1/shops/456/products.json", func(w http.ResponseWriter, _ *http.Request) {
+			created++
+			_, _ = fmt.Fprintf(w, `{"id":"copy_%d"}`, created)
+		})
+	})
+	defer closeFn()
+
+	products := NewClient(c)
+	results, _ := CloneAll(products, 123, products, 456, CloneOptions{Filter: func(p Product) bool {
+		return containsString(p.Tags, "summer")
+	}})
+	fmt.Println(CloneMapping(results))
+	// Output: map[prod_1:copy_1 prod_3:copy_2]
+}
@@ -0,0 +1,195 @@
+package product
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNoEnabledVariants is returned when an edit would leave a product without any enabled variant.
+var ErrNoEnabledVariants = errors.New("product must keep at least one enabled variant")
+
+// VariantSelector selects variants by option values: each key names an option and
+// lists the accepted value titles, for example
+//
+//	VariantSelector{"Color": {"Black"}, "Size": {"S", "M"}}
+//
+// A variant must match every option. Option names match the option's name or type,
+// ignoring case and a trailing "s", so "Color" matches the "Colors" option.
+type VariantSelector map[string][]string
+
+// SelectVariants returns the ids of the variants matching sel. An option the product
+// does not have matches no variant.
+func (p Product) SelectVariants(sel VariantSelector) []int {
+	var ids []int
+	for _, v := range p.Variants {
+		if p.matches(v, sel) {
+			ids = append(ids, v.Id)
+		}
+	}
+	return ids
+}
+
+// SetEnabled enables or disables the variants with the given ids and then calls
+// EnsureDefault. Nothing is changed when the product would be left without an
+// enabled variant.
+func (p *Product) SetEnabled(ids []int, enabled bool) error {
+	want := map[int]bool{}
+	for _, id := range ids {
+		want[id] = true
+	}
+	remaining := 0
+	for _, v := range p.Variants {
+		if (want[v.Id] && enabled) || (!want[v.Id] && v.IsEnabled) {
+			remaining++
+		}
+	}
+	if remaining == 0 {
+		return ErrNoEnabledVariants
+	}
+	for i := range p.Variants {
+		if want[p.Variants[i].Id] {
+			p.Variants[i].IsEnabled = enabled
+		}
+	}
+	return p.EnsureDefault()
+}
+
+// EnableVariants enables the variants matching sel.
+func (p *Product) EnableVariants(sel VariantSelector) error {
+	return p.SetEnabled(p.SelectVariants(sel), true)
+}
+
+// DisableVariants disables the variants matching sel, for example every 2XL size.
+func (p *Product) DisableVariants(sel VariantSelector) error {
+	return p.SetEnabled(p.SelectVariants(sel), false)
+}
+
+// SetDefault makes the variant with the given id the only default. The variant must be enabled.
+func (p *Product) SetDefault(id int) error {
+	found := false
+	for _, v := range p.Variants {
+		if v.Id == id {
+			if !v.IsEnabled {
+				return fmt.Errorf("variant %d is disabled and cannot be the default", id)
+			}
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("variant %d not found in product", id)
+	}
+	for i := range p.Variants {
+		p.Variants[i].IsDefault = p.Variants[i].Id == id
+	}
+	return nil
+}
+
+// EnsureDefault guarantees exactly one enabled default variant. When the current
+// default is missing or disabled, the first enabled variant becomes the default.
+func (p *Product) EnsureDefault() error {
+	def := -1
+	for i, v := range p.Variants {
+		if v.IsDefault && v.IsEnabled && def < 0 {
+			def = i
+		}
+	}
+	if def < 0 {
+		for i, v := range p.Variants {
+			if v.IsEnabled {
+				def = i
+				break
+			}
+		}
+	}
+	if def < 0 {
+		return ErrNoEnabledVariants
+	}
+	for i := range p.Variants {
+		p.Variants[i].IsDefault = i == def
+	}
+	return nil
+}
+
+// VariantEditResult is the outcome of EditVariants for one product.
+type VariantEditResult struct {
+	ProductId string
+	Changes   []Change
+	Err       error
+}
+
+// EditVariants applies edit to every product of the shop, across every page, accepted
+// by filter and saves the products that changed with PatchProduct.
+//
+// A nil filter accepts every product. Products that edit leaves unchanged are not
+// updated and not reported. An error from edit skips that product.
+func EditVariants(c Client, shopId int, filter func(p Product) bool, edit func(p *Product) error) ([]VariantEditResult, error) {
+	products, err := c.QueryProducts(shopId, ListProductsOptions{Filter: filter})
+	if err != nil {
+		return nil, err
+	}
+	var results []VariantEditResult
+	for _, p := range products {
+		edited := p
+		edited.Variants = append([]Variant(nil), p.Variants...)
+		if err := edit(&edited); err != nil {
+			results = append(results, VariantEditResult{ProductId: p.Id, Err: err})
+			continue
+		}
+		patch, changes := diff(p, edited)
+		if patch.IsEmpty() {
+			continue
+		}
+		result := VariantEditResult{ProductId: p.Id, Changes: changes}
+		_, result.Err = c.PatchProduct(shopId, p.Id, patch)
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (p Product) matches(v Variant, sel VariantSelector) bool {
+	for name, accepted := range sel {
+		opt := p.option(name)
+		if opt == nil {
+			return false
+		}
+		ok := false
+		for _, val := range opt.Values {
+			if !containsId(v.Options, val.Id) {
+				continue
+			}
+			for _, a := range accepted {
+				if strings.EqualFold(strings.TrimSpace(a), val.Title) {
+					ok = true
+				}
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (p Product) option(name string) *ProductOptions {
+	key := optionKey(name)
+	for i, opt := range p.Options {
+		if optionKey(opt.Name) == key || optionKey(opt.Type) == key {
+			return &p.Options[i]
+		}
+	}
+	return nil
+}
+
+func optionKey(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), "s")
+}
+
+func containsId(ids []int, id int) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package product
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func exampleMatrixProduct() Product {
+	return Product{
+		Id: "prod_1",
+		Options: []ProductOptions{
+			{Name: "Colors", Type: "color", Values: []ProductOptionValue{{Id: 1, Title: "Black"}, {Id: 2, Title: "White"}}},
+			{Name: "Sizes", Type: "size", Values: []ProductOptionValue{{Id: 10, Title: "S"}, {Id: 11, Title: "M"}, {Id: 12, Title: "XXL"}}},
+		},
+		Variants: []Variant{
+			{Id: 101, Options: []int{1, 10}, IsEnabled: true, IsDefault: true},
+			{Id: 102, Options: []int{1, 11}, IsEnabled: true},
+			{Id: 103, Options: []int{1, 12}, IsEnabled: true},
+			{Id: 201, Options: []int{2, 10}, IsEnabled: true},
+			{Id: 203, Options: []int{2, 12}, IsEnabled: false},
+		},
+	}
+}
+
+func ExampleProduct_SelectVariants() {
+	p := exampleMatrixProduct()
+	fmt.Println(p.SelectVariants(VariantSelector{"Color": {"black"}, "Size": {"S", "M"}}))
+	fmt.Println(p.SelectVariants(VariantSelector{"size": {"XXL"}}))
+	fmt.Println(p.SelectVariants(VariantSelector{"Material": {"Cotton"}}))
+	// Output:
+	// [101 102]
+	// [103 203]
+	// []
+}
+
+func ExampleProduct_DisableVariants() {
+	p := exampleMatrixProduct()
+	_ = p.DisableVariants(VariantSelector{"Size": {"S"}})
+	for _, v := range p.Variants {
+		fmt.Println(v.Id, v.IsEnabled, v.IsDefault)
+	}
+	fmt.Println(p.DisableVariants(VariantSelector{"Color": {"Black", "White"}}))
+	// Output:
+	// 101 false false
+	// 102 true true
+	// 103 true false
+	// 201 false false
+	// 203 false false
+	// product must keep at least one enabled variant
+}
+
+func ExampleEditVariants() {
+	c, closeFn := newProductTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/products.json", func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page") == "2" {
+				_, _ = w.Write([]byte(`{"current_page":2,"last_page":2,"data":[
+{"id":"prod_1","options":[{"name":"Sizes","type":"size","values":[{"id":10,"title":"S"},{"id":12,"title":"XXL"}]}],
+ "variants":[{"id":101,"options":[10],"is_enabled":true,"is_default":true},{"id":103,"options":[12],"is_enabled":true}]}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"current_page":1,"last_page":2,"data":[
+{"id":"prod_2","options":[{"name":"Sizes","type":"size","values":[{"id":10,"title":"S"}]}],
+ "variants":[{"id":101,"options":[10],"is_enabled":true,"is_default":true}]}]}`))
+		})
+		mux.HandleFunc("/v1/shops/123/products/prod_1.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"prod_1"}`))
+		})
+	})
+	defer closeFn()
+
+	results, _ := EditVariants(NewClient(c), 123, nil, func(p *Product) error {
+		return p.DisableVariants(VariantSelector{"Size": {"XXL"}})
+	})
+	for _, r := range results {
+		fmt.Println(r.ProductId, r.Changes, r.Err)
+	}
+	// Output: prod_1 [variants[103].is_enabled: true -> false] <nil>
+}
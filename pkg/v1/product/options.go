@@ -0,0 +1,17 @@
+package product
+
+// OptionValues maps each option name of the product, for example "Sizes", to the
+// title of the value the variant uses, for example "2XL".
+func (p Product) OptionValues(v Variant) map[string]string {
+	values := map[string]string{}
+	for _, opt := range p.Options {
+		for _, val := range opt.Values {
+			for _, id := range v.Options {
+				if val.Id == id {
+					values[opt.Name] = val.Title
+				}
+			}
+		}
+	}
+	return values
+}
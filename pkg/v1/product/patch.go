@@ -0,0 +1,178 @@
+package product
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+)
+
+// ProductPatch is a partial product update. Only the fields that are set are sent,
+// so fields left nil keep their current value in Printify.
+type ProductPatch struct {
+	Title       *string   `json:"title,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+	// Variants lists only the variants to change, identified by Id.
+	Variants                 []VariantPatch         `json:"variants,omitempty"`
+	PrintAreas               *[]PrintArea           `json:"print_areas,omitempty"`
+	PrintDetails             *[]common.PrintDetails `json:"print_details,omitempty"`
+	Visible                  *bool                  `json:"visible,omitempty"`
+	IsPrintifyExpressEnabled *bool                  `json:"is_printify_express_enabled,omitempty"`
+	IsEconomyShippingEnabled *bool                  `json:"is_economy_shipping_enabled,omitempty"`
+	SalesChannelProperties   *[]interface{}         `json:"sales_channel_properties,omitempty"`
+}
+
+// VariantPatch is a partial update of one variant.
+type VariantPatch struct {
+	Id        int           `json:"id"`
+	Price     *common.Money `json:"price,omitempty"`
+	IsEnabled *bool         `json:"is_enabled,omitempty"`
+	IsDefault *bool         `json:"is_default,omitempty"`
+}
+
+// IsEmpty reports whether the patch changes nothing.
+func (p ProductPatch) IsEmpty() bool {
+	return p.Title == nil && p.Description == nil && p.Tags == nil && len(p.Variants) == 0 &&
+		p.PrintAreas == nil && p.PrintDetails == nil && p.Visible == nil &&
+		p.IsPrintifyExpressEnabled == nil && p.IsEconomyShippingEnabled == nil && p.SalesChannelProperties == nil
+}
+
+// Change describes one changed field, for logging.
+type Change struct {
+	// Field is the JSON path of the field, for example "title" or "variants[17887].price".
+	Field string
+	Old   string
+	New   string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Field, c.Old, c.New)
+}
+
+// Diff returns the minimal patch turning old into new.
+//
+// Only fields that can be updated are compared; read only fields such as Id,
+// Images or BlueprintId are ignored. Variants are matched by Id, and variants
+// missing from new are left unchanged.
+func Diff(old, new Product) ProductPatch {
+	patch, _ := diff(old, new)
+	return patch
+}
+
+// Changes lists the fields Diff would change, with their old and new values.
+func Changes(old, new Product) []Change {
+	_, changes := diff(old, new)
+	return changes
+}
+
+func diff(old, new Product) (ProductPatch, []Change) {
+	var (
+		p       ProductPatch
+		changes []Change
+	)
+	change := func(field string, o, n any) {
+		changes = append(changes, Change{Field: field, Old: fmt.Sprint(o), New: fmt.Sprint(n)})
+	}
+
+	if old.Title != new.Title {
+		p.Title = &new.Title
+		change("title", quote(old.Title), quote(new.Title))
+	}
+	if old.Description != new.Description {
+		p.Description = &new.Description
+		change("description", quote(old.Description), quote(new.Description))
+	}
+	if !equalStrings(old.Tags, new.Tags) {
+		tags := append([]string{}, new.Tags...)
+		p.Tags = &tags
+		change("tags", fmt.Sprintf("%q", old.Tags), fmt.Sprintf("%q", tags))
+	}
+	if old.Visible != new.Visible {
+		p.Visible = &new.Visible
+		change("visible", old.Visible, new.Visible)
+	}
+	if old.IsPrintifyExpressEnabled != new.IsPrintifyExpressEnabled {
+		p.IsPrintifyExpressEnabled = &new.IsPrintifyExpressEnabled
+		change("is_printify_express_enabled", old.IsPrintifyExpressEnabled, new.IsPrintifyExpressEnabled)
+	}
+	if old.IsEconomyShippingEnabled != new.IsEconomyShippingEnabled {
+		p.IsEconomyShippingEnabled = &new.IsEconomyShippingEnabled
+		change("is_economy_shipping_enabled", old.IsEconomyShippingEnabled, new.IsEconomyShippingEnabled)
+	}
+	if !equalJSON(old.PrintAreas, new.PrintAreas) {
+		areas := append([]PrintArea{}, new.PrintAreas...)
+		p.PrintAreas = &areas
+		change("print_areas", fmt.Sprintf("%d areas", len(old.PrintAreas)), fmt.Sprintf("%d areas", len(areas)))
+	}
+	if !equalJSON(old.PrintDetails, new.PrintDetails) {
+		details := append([]common.PrintDetails{}, new.PrintDetails...)
+		p.PrintDetails = &details
+		change("print_details", fmt.Sprintf("%+v", old.PrintDetails), fmt.Sprintf("%+v", details))
+	}
+	if !equalJSON(old.SalesChannelProperties, new.SalesChannelProperties) {
+		props := append([]interface{}{}, new.SalesChannelProperties...)
+		p.SalesChannelProperties = &props
+		change("sales_channel_properties", fmt.Sprintf("%v", old.SalesChannelProperties), fmt.Sprintf("%v", props))
+	}
+
+	oldVariants := make(map[int]Variant, len(old.Variants))
+	for _, v := range old.Variants {
+		oldVariants[v.Id] = v
+	}
+	for _, n := range new.Variants {
+		o, existed := oldVariants[n.Id]
+		vp := VariantPatch{Id: n.Id}
+		field := fmt.Sprintf("variants[%d].", n.Id)
+		if !existed || o.Price.Amount != n.Price.Amount {
+			price := n.Price
+			vp.Price = &price
+			change(field+"price", o.Price.Decimal(), n.Price.Decimal())
+		}
+		if !existed || o.IsEnabled != n.IsEnabled {
+			enabled := n.IsEnabled
+			vp.IsEnabled = &enabled
+			change(field+"is_enabled", o.IsEnabled, n.IsEnabled)
+		}
+		if o.IsDefault != n.IsDefault {
+			isDefault := n.IsDefault
+			vp.IsDefault = &isDefault
+			change(field+"is_default", o.IsDefault, n.IsDefault)
+		}
+		if vp.Price != nil || vp.IsEnabled != nil || vp.IsDefault != nil {
+			p.Variants = append(p.Variants, vp)
+		}
+	}
+	return p, changes
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// equalJSON compares values by their JSON encoding, treating nil and empty slices alike.
+func equalJSON(a, b any) bool {
+	ja, _ := json.Marshal(a)
+	jb, _ := json.Marshal(b)
+	if isEmptyJSON(ja) && isEmptyJSON(jb) {
+		return true
+	}
+	return bytes.Equal(ja, jb)
+}
+
+func isEmptyJSON(data []byte) bool {
+	return string(data) == "null" || string(data) == "[]"
+}
+
+func quote(s string) string {
+	return fmt.Sprintf("%q", s)
+}
@@ -0,0 +1,59 @@
+package product
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+)
+
+func ExampleDiff() {
+	old := Product{
+		Id:      "prod_1",
+		Title:   "Logo Tee",
+		Tags:    []string{"tee", "logo"},
+		Visible: true,
+		Variants: []Variant{
+			{Id: 17887, Price: common.NewMoney(2500, ""), IsEnabled: true, IsDefault: true},
+			{Id: 17888, Price: common.NewMoney(2500, ""), IsEnabled: true},
+		},
+	}
+	updated := old
+	updated.Variants = []Variant{
+		{Id: 17887, Price: common.NewMoney(2500, ""), IsEnabled: true, IsDefault: true},
+		{Id: 17888, Price: common.NewMoney(2900, ""), IsEnabled: true},
+	}
+	updated.Tags = nil
+
+	body, _ := json.Marshal(Diff(old, updated))
+	fmt.Println(string(body))
+	for _, c := range Changes(old, updated) {
+		fmt.Println(c)
+	}
+	fmt.Println(Diff(old, old).IsEmpty())
+	// Output:
+	// {"tags":[],"variants":[{"id":17888,"price":2900}]}
+	// tags: ["tee" "logo"] -> []
+	// variants[17888].price: 25.00 -> 29.00
+	// true
+}
+
+func ExamplePatchProduct() {
+	c, closeFn := newProductTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/products/prod_1.json", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			fmt.Println(r.Method, string(body))
+			_, _ = w.Write([]byte(`{"id":"prod_1","title":"Logo Tee v2"}`))
+		})
+	})
+	defer closeFn()
+
+	title := "Logo Tee v2"
+	item, _ := PatchProduct(c, 123, "prod_1", ProductPatch{Title: &title})
+	fmt.Println(item.Title)
+	// Output:
+	// PUT {"title":"Logo Tee v2"}
+	// Logo Tee v2
+}
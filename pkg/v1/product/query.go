@@ -0,0 +1,78 @@
+package product
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+	"github.com/connellrobert/printify-go/pkg/v1/pagination"
+)
+
+// ListProductsOptions selects the pages returned by QueryProducts.
+type ListProductsOptions struct {
+	// Page fetches a single page. When zero, QueryProducts walks every page.
+	Page int
+	// Limit sets the page size requested from Printify.
+	Limit int
+	// Filter drops the products for which it returns false.
+	Filter func(p Product) bool
+}
+
+// Query returns the options as URL query parameters.
+func (opts ListProductsOptions) Query() url.Values {
+	query := url.Values{}
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Limit > 0 {
+		query.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	return query
+}
+
+var (
+	// ListProductsPage calls GET /v1/shops/{shopId}/products.json and returns a single
+	// page, including its pagination metadata.
+	//
+	// Signature:
+	//	func(c *common.Client, id int, opts ListProductsOptions) (*pagination.APIPagination[Product], error)
+	// Parameter mapping:
+	//	id -> {shopId}
+	//
+	// opts.Filter is not applied; use QueryProducts for that.
+	ListProductsPage = func(c *common.Client, id int, opts ListProductsOptions) (*pagination.APIPagination[Product], error) {
+		return common.ListPageWithId[Product, int](LIST_PRODUCTS_ENDPOINT)(c, id, opts.Query())
+	}
+	// QueryProducts calls GET /v1/shops/{shopId}/products.json and returns the products matching opts.
+	//
+	// Signature:
+	//	func(c *common.Client, id int, opts ListProductsOptions) ([]Product, error)
+	// Parameter mapping:
+	//	id -> {shopId}
+	//
+	// When opts.Page is zero every page is fetched in turn, starting at page 1, so the
+	// whole shop is returned. ListProducts only decodes the first page.
+	QueryProducts = func(c *common.Client, id int, opts ListProductsOptions) ([]Product, error) {
+		walk := opts.Page == 0
+		if walk {
+			opts.Page = 1
+		}
+
+		var products []Product
+		for {
+			page, err := ListProductsPage(c, id, opts)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range page.Data {
+				if opts.Filter == nil || opts.Filter(p) {
+					products = append(products, p)
+				}
+			}
+			if !walk || len(page.Data) == 0 || opts.Page >= page.LastPage {
+				return products, nil
+			}
+			opts.Page++
+		}
+	}
+)
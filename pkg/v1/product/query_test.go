@@ -0,0 +1,41 @@
+package product
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func ExampleQueryProducts() {
+	c, closeFn := newProductTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/products.json", func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("page") {
+			case "1":
+				_, _ = w.Write([]byte(`{"current_page":1,"last_page":2,"data":[{"id":"prod_1","visible":true},{"id":"prod_2"}]}`))
+			case "2":
+				_, _ = w.Write([]byte(`{"current_page":2,"last_page":2,"data":[{"id":"prod_3","visible":true}]}`))
+			}
+		})
+	})
+	defer closeFn()
+
+	items, _ := QueryProducts(c, 123, ListProductsOptions{Filter: func(p Product) bool { return p.Visible }})
+	for _, p := range items {
+		fmt.Println(p.Id)
+	}
+	// Output:
+	// prod_1
+	// prod_3
+}
+
+func ExampleListProductsPage() {
+	c, closeFn := newProductTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/products.json", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"current_page":%s,"last_page":4,"data":[{"id":"prod_7"}]}`, r.URL.Query().Get("page"))
+		})
+	})
+	defer closeFn()
+
+	page, _ := ListProductsPage(c, 123, ListProductsOptions{Page: 3, Limit: 50})
+	fmt.Println(page.CurrentPage, page.LastPage, page.Data[0].Id)
+	// Output: 3 4 prod_7
+}
@@ -0,0 +1,275 @@
+package product
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FontWeightEnum is a CSS style numeric font weight.
+type FontWeightEnum int
+
+const (
+	FontWeightThin       FontWeightEnum = 100
+	FontWeightExtraLight FontWeightEnum = 200
+	FontWeightLight      FontWeightEnum = 300
+	FontWeightNormal     FontWeightEnum = 400
+	FontWeightMedium     FontWeightEnum = 500
+	FontWeightSemiBold   FontWeightEnum = 600
+	FontWeightBold       FontWeightEnum = 700
+	FontWeightExtraBold  FontWeightEnum = 800
+	FontWeightBlack      FontWeightEnum = 900
+)
+
+// UnmarshalJSON accepts the weight as a number, as a numeric string, or as "normal" or "bold".
+//
+// The API documents font_weight as a string but returns integers. A null weight
+// leaves w unchanged.
+func (w *FontWeightEnum) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	parsed, err := parseFontWeight(v)
+	if err != nil {
+		return err
+	}
+	*w = parsed
+	return nil
+}
+
+// Valid reports whether w is one of the nine standard weights.
+func (w FontWeightEnum) Valid() bool {
+	return w >= FontWeightThin && w <= FontWeightBlack && w%100 == 0
+}
+
+// FontStyleEnum is the style of a text layer's font.
+type FontStyleEnum string
+
+const (
+	FontStyleNormal FontStyleEnum = "normal"
+	FontStyleItalic FontStyleEnum = "italic"
+)
+
+// TextAlignEnum aligns the lines of a text layer.
+type TextAlignEnum string
+
+const (
+	TextAlignLeft   TextAlignEnum = "left"
+	TextAlignCenter TextAlignEnum = "center"
+	TextAlignRight  TextAlignEnum = "right"
+)
+
+// SupportedFontFamilies, when not empty, restricts the font families accepted by
+// TextLayer.Validate.
+//
+// Printify does not publish its font list, so it is empty and any family is accepted.
+var SupportedFontFamilies []string
+
+var hexColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// ValidColor reports whether color is a #RGB or #RRGGBB hex color.
+func ValidColor(color string) bool {
+	return hexColor.MatchString(color)
+}
+
+// TextLayer is a text layer inside a print area placeholder.
+//
+// It encodes to the same JSON as its Image; use Image and Image.TextLayer to convert
+// between the two.
+type TextLayer struct {
+	Id         string         `json:"id"`
+	X          float64        `json:"x"`
+	Y          float64        `json:"y"`
+	Scale      float64        `json:"scale"`
+	Angle      int            `json:"angle"`
+	FontFamily string         `json:"font_family"`
+	FontSize   int            `json:"font_size"`
+	FontWeight FontWeightEnum `json:"font_weight"`
+	// FontColor is a #RGB or #RRGGBB hex color.
+	FontColor string        `json:"font_color"`
+	FontStyle FontStyleEnum `json:"font_style"`
+	InputText string        `json:"input_text"`
+	TextAlign TextAlignEnum `json:"text_align"`
+}
+
+// NewTextLayer creates a centered layer with text in black, normal weight and style.
+// The layer is validated before it is returned.
+func NewTextLayer(text string, fontFamily string, fontSize int) (*TextLayer, error) {
+	t := &TextLayer{
+		X:          0.5,
+		Y:          0.5,
+		Scale:      1,
+		FontFamily: fontFamily,
+		FontSize:   fontSize,
+		FontWeight: FontWeightNormal,
+		FontColor:  "#000000",
+		FontStyle:  FontStyleNormal,
+		InputText:  text,
+		TextAlign:  TextAlignCenter,
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Validate reports every invalid field at once.
+func (t TextLayer) Validate() error {
+	var errs []error
+	if strings.TrimSpace(t.InputText) == "" {
+		errs = append(errs, errors.New("input text is required"))
+	}
+	if strings.TrimSpace(t.FontFamily) == "" {
+		errs = append(errs, errors.New("font family is required"))
+	} else if len(SupportedFontFamilies) > 0 && !containsFold(SupportedFontFamilies, t.FontFamily) {
+		errs = append(errs, fmt.Errorf("font family %q is not supported", t.FontFamily))
+	}
+	if t.FontSize <= 0 {
+		errs = append(errs, fmt.Errorf("font size %d is not positive", t.FontSize))
+	}
+	if !t.FontWeight.Valid() {
+		errs = append(errs, fmt.Errorf("font weight %d is not one of 100, 200, ..., 900", t.FontWeight))
+	}
+	if !ValidColor(t.FontColor) {
+		errs = append(errs, fmt.Errorf("font color %q is not a #RGB or #RRGGBB hex color", t.FontColor))
+	}
+	switch t.FontStyle {
+	case FontStyleNormal, FontStyleItalic:
+	default:
+		errs = append(errs, fmt.Errorf("unsupported font style: %s", t.FontStyle))
+	}
+	switch t.TextAlign {
+	case TextAlignLeft, TextAlignCenter, TextAlignRight:
+	default:
+		errs = append(errs, fmt.Errorf("unsupported text align: %s", t.TextAlign))
+	}
+	if t.Scale <= 0 {
+		errs = append(errs, fmt.Errorf("scale %g is not positive", t.Scale))
+	}
+	return errors.Join(errs...)
+}
+
+// Image returns the layer as a placeholder Image.
+func (t TextLayer) Image() Image {
+	return Image{
+		Id:         t.Id,
+		X:          t.X,
+		Y:          t.Y,
+		Scale:      t.Scale,
+		Angle:      t.Angle,
+		FontFamily: t.FontFamily,
+		FontSize:   t.FontSize,
+		FontWeight: int(t.FontWeight),
+		FontColor:  t.FontColor,
+		FontStyle:  string(t.FontStyle),
+		InputText:  t.InputText,
+		TextAlign:  string(t.TextAlign),
+	}
+}
+
+// IsText reports whether img is a text layer rather than an uploaded image.
+func (img Image) IsText() bool {
+	return img.InputText != "" || img.FontFamily != ""
+}
+
+// MarshalJSON encodes a text layer with the fields of TextLayer only, leaving out the
+// image fields such as src, name, type, height and width. Other images encode every field.
+func (img Image) MarshalJSON() ([]byte, error) {
+	type plain Image
+	if !img.IsText() {
+		return json.Marshal(plain(img))
+	}
+	return json.Marshal(struct {
+		Id         string      `json:"id"`
+		X          float64     `json:"x"`
+		Y          float64     `json:"y"`
+		Scale      float64     `json:"scale"`
+		Angle      int         `json:"angle"`
+		FontFamily string      `json:"font_family"`
+		FontSize   int         `json:"font_size"`
+		FontWeight interface{} `json:"font_weight"`
+		FontColor  string      `json:"font_color"`
+		FontStyle  string      `json:"font_style"`
+		InputText  string      `json:"input_text"`
+		TextAlign  string      `json:"text_align"`
+	}{img.Id, img.X, img.Y, img.Scale, img.Angle, img.FontFamily, img.FontSize, img.FontWeight, img.FontColor, img.FontStyle, img.InputText, img.TextAlign})
+}
+
+// TextLayer returns img as a TextLayer. The bool is false when img is not a text
+// layer or its font weight cannot be read.
+func (img Image) TextLayer() (TextLayer, bool) {
+	if !img.IsText() {
+		return TextLayer{}, false
+	}
+	weight := FontWeightNormal
+	if img.FontWeight != nil {
+		w, err := parseFontWeight(img.FontWeight)
+		if err != nil {
+			return TextLayer{}, false
+		}
+		weight = w
+	}
+	return TextLayer{
+		Id:         img.Id,
+		X:          img.X,
+		Y:          img.Y,
+		Scale:      img.Scale,
+		Angle:      img.Angle,
+		FontFamily: img.FontFamily,
+		FontSize:   img.FontSize,
+		FontWeight: weight,
+		FontColor:  img.FontColor,
+		FontStyle:  FontStyleEnum(img.FontStyle),
+		InputText:  img.InputText,
+		TextAlign:  TextAlignEnum(img.TextAlign),
+	}, true
+}
+
+// AddText validates t and appends it to the placeholder's layers.
+func (ph *Placeholder) AddText(t TextLayer) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	ph.Images = append(ph.Images, t.Image())
+	return nil
+}
+
+func parseFontWeight(v interface{}) (FontWeightEnum, error) {
+	switch w := v.(type) {
+	case float64:
+		return FontWeightEnum(w), nil
+	case int:
+		return FontWeightEnum(w), nil
+	case FontWeightEnum:
+		return w, nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(w)) {
+		case "normal", "":
+			return FontWeightNormal, nil
+		case "bold":
+			return FontWeightBold, nil
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(w))
+		if err != nil {
+			return 0, fmt.Errorf("invalid font weight %q", w)
+		}
+		return FontWeightEnum(n), nil
+	}
+	return 0, fmt.Errorf("invalid font weight %v", v)
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, strings.TrimSpace(value)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package product
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func ExampleNewTextLayer() {
+	t, _ := NewTextLayer("Happy Birthday, Sam", "Pacifico", 48)
+	t.FontWeight = FontWeightBold
+	t.FontColor = "#c0392b"
+
+	ph := Placeholder{Position: "front"}
+	_ = ph.AddText(*t)
+	data, _ := json.Marshal(t)
+	fmt.Println(string(data))
+	image, _ := json.Marshal(ph.Images[0])
+	fmt.Println(string(image) == string(data))
+	fmt.Println(ph.Images[0].IsText(), ph.Images[0].FontWeight)
+	// Output:
+	// {"id":"","x":0.5,"y":0.5,"scale":1,"angle":0,"font_family":"Pacifico","font_size":48,"font_weight":700,"font_color":"#c0392b","font_style":"normal","input_text":"Happy Birthday, Sam","text_align":"center"}
+	// true
+	// true 700
+}
+
+func ExampleTextLayer_Validate() {
+	t := TextLayer{FontFamily: "Comic Sans", FontSize: 12, FontWeight: 450, FontColor: "red", FontStyle: FontStyleItalic, TextAlign: TextAlignLeft, Scale: 1}
+	fmt.Println(t.Validate())
+
+	// Font families are only checked against a list set by the caller.
+	SupportedFontFamilies = []string{"Pacifico", "Roboto"}
+	defer func() { SupportedFontFamilies = nil }()
+	t.InputText, t.FontWeight, t.FontColor = "Hi", FontWeightNormal, "#000"
+	fmt.Println(t.Validate())
+	// Output:
+	// input text is required
+	// font weight 450 is not one of 100, 200, ..., 900
+	// font color "red" is not a #RGB or #RRGGBB hex color
+	// font family "Comic Sans" is not supported
+}
+
+func ExampleImage_TextLayer() {
+	var img Image
+	_ = json.Unmarshal([]byte(`{"id":"txt_1","x":0.5,"y":0.2,"scale":1,"font_family":"Roboto","font_size":24,"font_weight":"600","font_color":"#fff","input_text":"Team"}`), &img)
+	t, ok := img.TextLayer()
+	fmt.Println(ok, t.InputText, t.FontWeight == FontWeightSemiBold)
+
+	var layer TextLayer
+	_ = json.Unmarshal([]byte(`{"font_weight":"bold"}`), &layer)
+	fmt.Println(layer.FontWeight)
+
+	err := json.Unmarshal([]byte(`{"font_weight":null}`), &layer)
+	fmt.Println(layer.FontWeight, err)
+	// Output:
+	// true Team true
+	// 700
+	// 700 <nil>
+}
@@ -21,9 +21,9 @@ type Product struct {
 	// Mock-up images are read only values. The mock-up images are grouped by variants and position. See mock-up image properties for reference.
 	Images []MockupImage `json:"images"`
 	// The date and time when a product was created.
-	CreatedAt string `json:"created_at"`
+	CreatedAt common.Timestamp `json:"created_at"`
 	// The date and time when a product was last updated.
-	UpdateAt string `json:"update_at"`
+	UpdateAt common.Timestamp `json:"update_at"`
 	// Used for publishing. Visibility in sales channel. Can be true or false, defaults to true.
 	Visible bool `json:"visible"`
 	// Required when creating a product, but is read only after. See catalog for how to get blueprint_id.
@@ -57,6 +57,7 @@ type Product struct {
 	// Flag to indicate if Economy Shipping is enabled for the product. Economy Shipping can be enabled only for eligible products (see is_economy_shipping_eligible flag). Defaults to false.
 	IsEconomyShippingEnabled bool `json:"is_economy_shipping_enabled"`
 	// Lists product properties specific to the sales channel associated with the product, if the sales channel has such custom properties, the attributes are listed in the array and may be actionable, but for all custom integrations, it will either be null or an empty array.
+	// Use TypedSalesChannelProperties and SetSalesChannelProperties to work with typed values.
 	SalesChannelProperties []interface{} `json:"sales_channel_properties"`
 }
 
@@ -81,9 +82,9 @@ type Variant struct {
 	// Optional unique string identifier for the product variant. If one is not provided, one will be generated by Printify.
 	Sku string `json:"sku"`
 	// Price in cents, integer value.
-	Price int `json:"price"`
+	Price common.Money `json:"price"`
 	// Product variant's fulfillment cost in cents, integer value.
-	Cost int `json:"cost"`
+	Cost common.Money `json:"cost"`
 	// Variant title.
 	Title string `json:"title"`
 	// Weight in grams for a product variant
@@ -198,6 +199,7 @@ type Publish struct {
 
 // Updated by sales channel with publishing succeeded endpoint. Id and handle are external references in the sales channel. See publishing succeeded endpoint for more reference.
 // Shipping Template ID is optional and can be passed during product creation or update.
+// Channel specific product properties are not part of the reference; see Product.SalesChannelProperties.
 type PublishReference struct {
 	Id                 string `json:"id"`
 	Handle             string `json:"handle"`
@@ -0,0 +1,135 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/connellrobert/printify-go/pkg/v1/catalog"
+)
+
+// ValidationError lists every problem Validate found with a product.
+type ValidationError struct {
+	Issues []error
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Issues))
+	for i, err := range e.Issues {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e *ValidationError) Unwrap() []error {
+	return e.Issues
+}
+
+// Validate checks p against the catalog before CreateProduct or UpdateProduct.
+//
+// It checks that the print provider offers the blueprint, that every variant exists
+// for the pair, that every enabled variant belongs to exactly one print area, that
+// each print area's placeholders are offered by its variants, and that enabled
+// variants are priced above cost. The catalog does not expose costs, so the price
+// check only applies to variants with Cost set, such as products fetched with
+// GetProduct.
+//
+// Problems are returned together as a *ValidationError. Catalog request failures and
+// ctx cancellation are returned as they are.
+func Validate(ctx context.Context, c catalog.Client, p Product) error {
+	var issues []error
+	issue := func(format string, args ...any) {
+		issues = append(issues, fmt.Errorf(format, args...))
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	providers, err := c.ListPrintProvidersByBlueprint(p.BlueprintId)
+	if err != nil {
+		return err
+	}
+	offered := false
+	for _, pp := range providers {
+		offered = offered || pp.Id == p.PrintProviderId
+	}
+	if !offered {
+		issue("print provider %d does not offer blueprint %d", p.PrintProviderId, p.BlueprintId)
+		return &ValidationError{Issues: issues}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	variants, err := c.ListVariantsByBlueprintPrintProvider(p.BlueprintId, p.PrintProviderId)
+	if err != nil {
+		return err
+	}
+	positions := map[int]map[string]bool{}
+	for _, v := range variants {
+		positions[v.Id] = map[string]bool{}
+		for _, ph := range v.Placeholders {
+			positions[v.Id][ph.Position] = true
+		}
+	}
+
+	inProduct := map[int]bool{}
+	enabled := 0
+	for _, v := range p.Variants {
+		if inProduct[v.Id] {
+			issue("variant %d is listed more than once", v.Id)
+			continue
+		}
+		inProduct[v.Id] = true
+		if _, ok := positions[v.Id]; !ok {
+			issue("variant %d is not offered for blueprint %d and print provider %d", v.Id, p.BlueprintId, p.PrintProviderId)
+		}
+		if !v.IsEnabled {
+			continue
+		}
+		enabled++
+		switch {
+		case v.Price.Amount <= 0:
+			issue("variant %d has no price", v.Id)
+		case v.Cost.Amount > 0 && v.Price.Amount <= v.Cost.Amount:
+			issue("variant %d price %s is not above cost %s", v.Id, v.Price.Decimal(), v.Cost.Decimal())
+		}
+	}
+	if enabled == 0 {
+		issue("no variant is enabled")
+	}
+
+	area := map[int]int{}
+	for i, a := range p.PrintAreas {
+		for _, id := range a.VariantIds {
+			if prev, ok := area[id]; ok {
+				issue("variant %d is in print areas %d and %d", id, prev, i)
+				continue
+			}
+			area[id] = i
+			if !inProduct[id] {
+				issue("print area %d lists variant %d, which is not a product variant", i, id)
+				continue
+			}
+			offeredPositions, ok := positions[id]
+			if !ok {
+				continue
+			}
+			for _, ph := range a.Placeholders {
+				if !offeredPositions[ph.Position] {
+					issue("variant %d has no %q placeholder", id, ph.Position)
+				}
+			}
+		}
+	}
+	for _, v := range p.Variants {
+		if _, ok := area[v.Id]; v.IsEnabled && !ok {
+			issue("enabled variant %d is not in any print area", v.Id)
+		}
+	}
+
+	if len(issues) > 0 {
+		return &ValidationError{Issues: issues}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/connellrobert/printify-go/pkg/v1/catalog"
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+)
+
+func ExampleValidate() {
+	c, closeFn := newProductTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/catalog/blueprints/6/print_providers.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"data":[{"id":99,"title":"Provider A"}]}`))
+		})
+		mux.HandleFunc("/v1/catalog/blueprints/6/print_providers/99/variants.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(builderVariants))
+		})
+	})
+	defer closeFn()
+
+	usd := func(cents int) common.Money { return common.Money{Amount: cents, Currency: "USD"} }
+	p := Product{
+		BlueprintId:     6,
+		PrintProviderId: 99,
+		Variants: []Variant{
+			{Id: 1, Price: usd(2500), Cost: usd(1100), IsEnabled: true},
+			{Id: 2, Price: usd(1000), Cost: usd(1400), IsEnabled: true},
+			{Id: 3, Price: usd(2500), IsEnabled: true},
+			{Id: 42, Price: usd(2500)},
+		},
+		PrintAreas: []PrintArea{{
+			VariantIds:   []int{1, 2, 3},
+			Placeholders: []Placeholder{{Position: "front"}, {Position: "back"}},
+		}},
+	}
+	err := Validate(context.Background(), catalog.NewClient(c), p)
+	fmt.Println(err)
+
+	var verr *ValidationError
+	fmt.Println(errors.As(err, &verr), len(verr.Issues))
+
+	p.PrintProviderId = 7
+	fmt.Println(Validate(context.Background(), catalog.NewClient(c), p))
+	// Output:
+	// variant 2 price 10.00 is not above cost 14.00
+	// variant 42 is not offered for blueprint 6 and print provider 99
+	// variant 3 has no "back" placeholder
+	// true 3
+	// print provider 7 does not offer blueprint 6
+}
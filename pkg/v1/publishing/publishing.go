@@ -0,0 +1,204 @@
+package publishing
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/connellrobert/printify-go/pkg/v1/events"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+)
+
+// DefaultMaxAttempts is the number of sales channel handoffs tried before a publication is reported as failed.
+const DefaultMaxAttempts = 3
+
+// Orchestrator drives the publishing lifecycle of products:
+//
+//	Request  -> PublishProduct                                   (requested)
+//	Start    -> Channel.Publish, then UpdatePublishStatusToSucceeded (succeeded)
+//	            or, after MaxAttempts, UpdatePublishStatusToFailed   (failed)
+//	Unpublish -> Channel.Unpublish, then NotifyProductUnpublished  (unpublished)
+//
+// Every call to a publishing endpoint goes through Limiter.
+type Orchestrator struct {
+	Products product.Client
+	ShopId   int
+	Channel  Channel
+	// Store defaults to a MemoryStore.
+	Store Store
+	// Limiter defaults to Printify's publishing rate limit.
+	Limiter *RateLimiter
+	// MaxAttempts defaults to DefaultMaxAttempts.
+	MaxAttempts int
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	init sync.Once
+}
+
+// NewOrchestrator creates an Orchestrator with a MemoryStore and Printify's publishing rate limit.
+func NewOrchestrator(products product.Client, shopId int, channel Channel) *Orchestrator {
+	return &Orchestrator{
+		Products: products,
+		ShopId:   shopId,
+		Channel:  channel,
+		Store:    NewMemoryStore(),
+		Limiter:  NewRateLimiter(DefaultRateLimit, DefaultRateWindow),
+	}
+}
+
+// Request asks Printify to publish a product with PublishProduct.
+func (o *Orchestrator) Request(productId string, body product.Publish) error {
+	o.defaults()
+	o.Limiter.Wait()
+	if err := o.Products.PublishProduct(o.ShopId, productId, body); err != nil {
+		return err
+	}
+	return o.save(&Record{ProductId: productId, State: StateRequested})
+}
+
+// HandleEvent reacts to product:publish_started events by calling Start. Other events are ignored.
+func (o *Orchestrator) HandleEvent(e events.Event) error {
+	if events.EventTypeEnum(e.Type) != events.PRODUCT_PUBLISH_STARTED {
+		return nil
+	}
+	_, err := o.Start(e.Resource.Id)
+	return err
+}
+
+// Start hands a product whose publishing started off to the sales channel and
+// reports the outcome to Printify.
+//
+// The stored record is continued, so a redelivered product:publish_started event
+// neither publishes a succeeded or failed product again nor resets Attempts; call
+// Request to publish such a product again. A failed handoff leaves the product
+// started with LastError set so Retry can try again; after MaxAttempts, counted
+// across events, the failure is reported with UpdatePublishStatusToFailed. The
+// error is only set when the product cannot be fetched or the record cannot be
+// loaded or saved.
+func (o *Orchestrator) Start(productId string) (Record, error) {
+	o.defaults()
+	r, ok, err := o.Store.Get(productId)
+	if err != nil {
+		return r, err
+	}
+	switch {
+	case !ok:
+		r = Record{ProductId: productId}
+	case r.State == StateSucceeded || r.State == StateFailed:
+		return r, nil
+	case r.State != StateStarted:
+		// A new publication after Request or Unpublish.
+		r.Attempts, r.LastError = 0, ""
+	}
+	r.State = StateStarted
+	err = o.handoff(&r)
+	return r, err
+}
+
+// Retry retries every started product with a failed handoff and returns every
+// retried record. A product that cannot be fetched or saved does not stop the others;
+// the errors of all products are joined.
+func (o *Orchestrator) Retry() ([]Record, error) {
+	o.defaults()
+	records, err := o.Store.List()
+	if err != nil {
+		return nil, err
+	}
+	var retried []Record
+	var errs []error
+	for _, r := range records {
+		if r.State != StateStarted || r.LastError == "" {
+			continue
+		}
+		if err := o.handoff(&r); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", r.ProductId, err))
+		}
+		retried = append(retried, r)
+	}
+	return retried, errors.Join(errs...)
+}
+
+// Unpublish removes a product from the sales channel and tells Printify with NotifyProductUnpublished.
+func (o *Orchestrator) Unpublish(productId string) error {
+	o.defaults()
+	r, _, err := o.Store.Get(productId)
+	if err != nil {
+		return err
+	}
+	r.ProductId = productId
+	if err := o.Channel.Unpublish(productId, r.External); err != nil {
+		return err
+	}
+	o.Limiter.Wait()
+	if err := o.Products.NotifyProductUnpublished(o.ShopId, productId); err != nil {
+		return err
+	}
+	r.State, r.External, r.LastError = StateUnpublished, product.PublishReference{}, ""
+	return o.save(&r)
+}
+
+func (o *Orchestrator) handoff(r *Record) error {
+	if r.External.Id == "" {
+		p, err := o.Products.GetProduct(o.ShopId, r.ProductId)
+		if err != nil {
+			return err
+		}
+		r.Attempts++
+		ref, err := o.Channel.Publish(*p)
+		if err != nil {
+			r.LastError = err.Error()
+			if r.Attempts < o.maxAttempts() {
+				return o.save(r)
+			}
+			o.Limiter.Wait()
+			if err := o.Products.UpdatePublishStatusToFailed(o.ShopId, r.ProductId, product.PublishFailedRequest{Reason: r.LastError}); err != nil {
+				r.LastError = fmt.Sprintf("report failure: %v", err)
+				return o.save(r)
+			}
+			r.State = StateFailed
+			return o.save(r)
+		}
+		r.External = ref
+	}
+
+	o.Limiter.Wait()
+	if err := o.Products.UpdatePublishStatusToSucceeded(o.ShopId, r.ProductId, r.External); err != nil {
+		r.LastError = fmt.Sprintf("report success: %v", err)
+		return o.save(r)
+	}
+	r.State, r.LastError = StateSucceeded, ""
+	return o.save(r)
+}
+
+func (o *Orchestrator) save(r *Record) error {
+	r.UpdatedAt = o.now()
+	return o.Store.Put(*r)
+}
+
+// defaults fills in Store and Limiter on first use, so a zero Orchestrator works.
+func (o *Orchestrator) defaults() {
+	o.init.Do(func() {
+		if o.Store == nil {
+			o.Store = NewMemoryStore()
+		}
+		if o.Limiter == nil {
+			o.Limiter = NewRateLimiter(DefaultRateLimit, DefaultRateWindow)
+		}
+	})
+}
+
+func (o *Orchestrator) maxAttempts() int {
+	if o.MaxAttempts > 0 {
+		return o.MaxAttempts
+	}
+	return DefaultMaxAttempts
+}
+
+func (o *Orchestrator) now() time.Time {
+	if o.Now != nil {
+		return o.Now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,171 @@
+package publishing
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+	"github.com/connellrobert/printify-go/pkg/v1/events"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+)
+
+func newPublishingTestClient(register func(mux *http.ServeMux)) (*common.Client, func()) {
+	mux := http.NewServeMux()
+	register(mux)
+	srv := httptest.NewServer(mux)
+	c := common.NewClient("printify_pat", 123)
+	c.Host = srv.URL
+	return c, srv.Close
+}
+
+// flakyChannel fails the first publish of every product.
+type flakyChannel struct {
+	seen map[string]bool
+}
+
+func (f *flakyChannel) Publish(p product.Product) (product.PublishReference, error) {
+	if !f.seen[p.Id] {
+		f.seen[p.Id] = true
+		return product.PublishReference{}, errors.New("storefront timeout")
+	}
+	return product.PublishReference{Id: "shopify_" + p.Id, Handle: "/products/" + p.Id}, nil
+}
+
+func (f *flakyChannel) Unpublish(productId string, ref product.PublishReference) error {
+	return nil
+}
+
+// countingChannel counts publishes and fails while fail is set.
+type countingChannel struct {
+	publishes int
+	fail      bool
+}
+
+func (c *countingChannel) Publish(p product.Product) (product.PublishReference, error) {
+	c.publishes++
+	if c.fail {
+		return product.PublishReference{}, errors.New("storefront down")
+	}
+	return product.PublishReference{Id: "shopify_" + p.Id}, nil
+}
+
+func (c *countingChannel) Unpublish(productId string, ref product.PublishReference) error {
+	return nil
+}
+
+func ExampleOrchestrator() {
+	c, closeFn := newPublishingTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/products/prod_1.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"prod_1","title":"Logo Tee"}`))
+		})
+		mux.HandleFunc("/v1/shops/123/products/prod_1/", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			fmt.Println(strings.TrimSpace(strings.TrimPrefix(r.URL.Path, "/v1/shops/123/products/prod_1/") + " " + string(body)))
+		})
+	})
+	defer closeFn()
+
+	o := NewOrchestrator(product.NewClient(c), 123, &flakyChannel{seen: map[string]bool{}})
+	_ = o.Request("prod_1", product.Publish{Title: true})
+
+	_ = o.HandleEvent(events.Event{Type: string(events.PRODUCT_PUBLISH_STARTED), Resource: events.EventResource{Id: "prod_1", Type: "product"}})
+	r, _, _ := o.Store.Get("prod_1")
+	fmt.Println(r.State, r.Attempts, r.LastError)
+
+	retried, _ := o.Retry()
+	fmt.Println(retried[0].State, retried[0].Attempts, retried[0].External.Id)
+
+	_ = o.Unpublish("prod_1")
+	r, _, _ = o.Store.Get("prod_1")
+	fmt.Println(r.State)
+	// Output:
+	// publish.json {"images":false,"variants":false,"title":true,"description":false,"tags":false,"key_features":false,"shipping_template":false}
+	// started 1 storefront timeout
+	// publishing_succeeded.json {"id":"shopify_prod_1","handle":"/products/prod_1"}
+	// succeeded 2 shopify_prod_1
+	// unpublished.json
+	// unpublished
+}
+
+func ExampleRateLimiter() {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(2, time.Minute)
+	l.Now = func() time.Time { return now }
+	l.Sleep = func(d time.Duration) {
+		fmt.Println("waiting", d)
+		now = now.Add(d)
+	}
+	for i := 0; i < 3; i++ {
+		l.Wait()
+		now = now.Add(10 * time.Second)
+	}
+	// Output: waiting 40s
+}
+
+func ExampleOrchestrator_Start() {
+	c, closeFn := newPublishingTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/products/", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"` + strings.Split(r.URL.Path, "/")[5] + `"}`))
+		})
+	})
+	defer closeFn()
+
+	// A zero Orchestrator defaults its Store and Limiter.
+	channel := &countingChannel{}
+	o := &Orchestrator{Products: product.NewClient(c), ShopId: 123, Channel: channel, MaxAttempts: 2}
+	started := events.Event{Type: string(events.PRODUCT_PUBLISH_STARTED), Resource: events.EventResource{Id: "prod_1", Type: "product"}}
+
+	// A redelivered event after success does not publish again.
+	_ = o.HandleEvent(started)
+	_ = o.HandleEvent(started)
+	r, _, _ := o.Store.Get("prod_1")
+	fmt.Println(r.State, r.Attempts, "publishes:", channel.publishes)
+
+	// Attempts are counted across events, so MaxAttempts is enforced.
+	channel.fail = true
+	for i := 0; i < 3; i++ {
+		r, _ = o.Start("prod_2")
+		fmt.Println(r.State, r.Attempts)
+	}
+	// Output:
+	// succeeded 1 publishes: 1
+	// started 1
+	// failed 2
+	// failed 2
+}
+
+func ExampleOrchestrator_Retry() {
+	c, closeFn := newPublishingTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/products/", func(w http.ResponseWriter, r *http.Request) {
+			id := strings.Split(r.URL.Path, "/")[5]
+			if strings.HasPrefix(id, "prod_1") {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":"` + strings.TrimSuffix(id, ".json") + `"}`))
+		})
+	})
+	defer closeFn()
+
+	o := &Orchestrator{Products: product.NewClient(c), ShopId: 123, Channel: &countingChannel{}}
+	o.defaults()
+	for _, id := range []string{"prod_1", "prod_2"} {
+		_ = o.Store.Put(Record{ProductId: id, State: StateStarted, Attempts: 1, LastError: "storefront timeout"})
+	}
+
+	// A product that cannot be fetched does not stop the others from being retried.
+	retried, err := o.Retry()
+	for _, r := range retried {
+		fmt.Println(r.ProductId, r.State)
+	}
+	fmt.Println(err != nil)
+	// Output:
+	// prod_1 started
+	// prod_2 succeeded
+	// true
+}
@@ -0,0 +1,70 @@
+package publishing
+
+import (
+	"sync"
+	"time"
+)
+
+// Printify's publishing rate limit.
+const (
+	DefaultRateLimit  = 200
+	DefaultRateWindow = 30 * time.Minute
+)
+
+// RateLimiter allows at most Limit calls in any Window, blocking callers until a slot frees up.
+type RateLimiter struct {
+	Limit  int
+	Window time.Duration
+	// Now and Sleep default to time.Now and time.Sleep.
+	Now   func() time.Time
+	Sleep func(d time.Duration)
+
+	mu    sync.Mutex
+	calls []time.Time
+}
+
+// NewRateLimiter creates a RateLimiter.
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{Limit: limit, Window: window}
+}
+
+// Wait blocks until a call is allowed and records it.
+func (l *RateLimiter) Wait() {
+	l.mu.Lock()
+	for {
+		now := l.now()
+		cutoff := now.Add(-l.Window)
+		kept := l.calls[:0]
+		for _, t := range l.calls {
+			if t.After(cutoff) {
+				kept = append(kept, t)
+			}
+		}
+		l.calls = kept
+		if l.Limit <= 0 || len(l.calls) < l.Limit {
+			l.calls = append(l.calls, now)
+			l.mu.Unlock()
+			return
+		}
+		wait := l.calls[0].Sub(cutoff)
+		// Other callers may record calls, and find the limiter full, while this one sleeps.
+		l.mu.Unlock()
+		l.sleep(wait)
+		l.mu.Lock()
+	}
+}
+
+func (l *RateLimiter) now() time.Time {
+	if l.Now != nil {
+		return l.Now()
+	}
+	return time.Now()
+}
+
+func (l *RateLimiter) sleep(d time.Duration) {
+	if l.Sleep != nil {
+		l.Sleep(d)
+		return
+	}
+	time.Sleep(d)
+}
@@ -0,0 +1,96 @@
+package publishing
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+)
+
+// StateEnum is where a product is in the publishing lifecycle.
+type StateEnum string
+
+const (
+	// StateRequested means PublishProduct was called and Printify has not started publishing yet.
+	StateRequested StateEnum = "requested"
+	// StateStarted means Printify sent product:publish_started and the product is being
+	// handed off to the sales channel.
+	StateStarted StateEnum = "started"
+	// StateSucceeded means the sales channel published the product and Printify was told.
+	StateSucceeded StateEnum = "succeeded"
+	// StateFailed means publishing failed and Printify was told.
+	StateFailed StateEnum = "failed"
+	// StateUnpublished means the product was removed from the sales channel and Printify was told.
+	StateUnpublished StateEnum = "unpublished"
+)
+
+// Record is the publishing state of one product.
+type Record struct {
+	ProductId string    `json:"product_id"`
+	State     StateEnum `json:"state"`
+	// External is the sales channel reference once the channel has published the product.
+	External product.PublishReference `json:"external"`
+	// Attempts counts handoffs to the sales channel for the current publication.
+	Attempts int `json:"attempts"`
+	// LastError is the last handoff error. A started record with an error is retried by Retry.
+	LastError string    `json:"last_error,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Channel is the sales channel integration products are published to.
+type Channel interface {
+	// Publish creates or updates the product in the sales channel and returns its reference.
+	Publish(p product.Product) (product.PublishReference, error)
+	// Unpublish removes the product from the sales channel.
+	Unpublish(productId string, ref product.PublishReference) error
+}
+
+// Store keeps publishing records.
+//
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the record of a product. The bool is false when there is none.
+	Get(productId string) (Record, bool, error)
+	// Put saves r, replacing any earlier record of the same product.
+	Put(r Record) error
+	// List returns every record.
+	List() ([]Record, error)
+}
+
+// MemoryStore is an in-memory Store.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: map[string]Record{}}
+}
+
+func (s *MemoryStore) Get(productId string) (Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.records[productId]
+	return r, ok, nil
+}
+
+func (s *MemoryStore) Put(r Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[r.ProductId] = r
+	return nil
+}
+
+// List returns every record sorted by product id.
+func (s *MemoryStore) List() ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Record, 0, len(s.records))
+	for _, r := range s.records {
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ProductId < out[j].ProductId })
+	return out, nil
+}
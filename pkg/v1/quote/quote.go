@@ -0,0 +1,193 @@
+package quote
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+	catalogv2 "github.com/connellrobert/printify-go/pkg/v2/catalog"
+)
+
+// restOfTheWorld is the country code Printify uses for shipping profiles that cover every other destination.
+const restOfTheWorld = "REST_OF_THE_WORLD"
+
+// DefaultTransitTime returns rough business-day transit estimates per method.
+//
+// Printify does not expose carrier transit times, so these are intentionally
+// conservative. Set Quoter.TransitTime to use estimates for your destinations.
+func DefaultTransitTime(method MethodEnum, country string) Window {
+	switch method {
+	case MethodPriority:
+		return Window{MinDays: 2, MaxDays: 5}
+	case MethodPrintifyExpress:
+		return Window{MinDays: 1, MaxDays: 3}
+	case MethodEconomy:
+		return Window{MinDays: 5, MaxDays: 12}
+	default:
+		return Window{MinDays: 3, MaxDays: 8}
+	}
+}
+
+// Quoter prices every shipping method for a cart and destination.
+type Quoter struct {
+	Orders  order.Client
+	Catalog catalogv2.Client
+	ShopId  int
+	// TransitTime estimates days in transit for a method and destination country.
+	// DefaultTransitTime is used when nil.
+	TransitTime func(method MethodEnum, country string) Window
+}
+
+// NewQuoter creates a Quoter using DefaultTransitTime.
+func NewQuoter(orders order.Client, catalog catalogv2.Client, shopId int) *Quoter {
+	return &Quoter{
+		Orders:      orders,
+		Catalog:     catalog,
+		ShopId:      shopId,
+		TransitTime: DefaultTransitTime,
+	}
+}
+
+// Quote returns one Option per shipping method, ranked for display at checkout.
+//
+// Prices come from order.CalculateShippingCosts and handling times from the v2
+// catalog shipping endpoints. Eligible options come first, ordered by price and
+// then by latest delivery day; ineligible options follow with their Reasons.
+func (q *Quoter) Quote(cart []CartItem, to order.Address) ([]Option, error) {
+	if len(cart) == 0 {
+		return nil, fmt.Errorf("cart is empty")
+	}
+
+	req := order.ShipmentCalculationRequest{AddressTo: to}
+	for _, item := range cart {
+		req.LineItems = append(req.LineItems, order.LineItem{
+			ProductId: item.ProductId,
+			VariantId: strconv.Itoa(item.VariantId),
+			Quantity:  item.Quantity,
+		})
+	}
+	costs, err := q.Orders.CalculateShippingCosts(q.ShopId, req)
+	if err != nil {
+		return nil, err
+	}
+
+	transit := q.TransitTime
+	if transit == nil {
+		transit = DefaultTransitTime
+	}
+
+	options := make([]Option, 0, len(Methods))
+	for _, method := range Methods {
+		opt := Option{
+			Method:         method,
+			ShippingMethod: method.ShippingMethod(),
+			Price:          price(costs, method),
+			Transit:        transit(method, to.Country),
+			Eligible:       true,
+		}
+		if opt.Price.Amount <= 0 {
+			opt.Eligible = false
+			opt.Reasons = append(opt.Reasons, "not offered for this cart")
+		}
+		q.applyHandlingTimes(&opt, cart, to.Country)
+		opt.Delivery = opt.HandlingTime.Add(opt.Transit)
+		options = append(options, opt)
+	}
+
+	sort.SliceStable(options, func(i, j int) bool {
+		a, b := options[i], options[j]
+		if a.Eligible != b.Eligible {
+			return a.Eligible
+		}
+		if a.Price.Amount != b.Price.Amount {
+			return a.Price.Amount < b.Price.Amount
+		}
+		return a.Delivery.MaxDays < b.Delivery.MaxDays
+	})
+	return options, nil
+}
+
+// applyHandlingTimes sets the option's handling time to the slowest item in the cart,
+// marking the option ineligible when any item does not ship to country with the method.
+func (q *Quoter) applyHandlingTimes(opt *Option, cart []CartItem, country string) {
+	type pair struct{ blueprint, provider int }
+	infos := map[pair]*catalogv2.ShippingInfo{}
+
+	for _, item := range cart {
+		p := pair{item.BlueprintId, item.PrintProviderId}
+		info, ok := infos[p]
+		if !ok {
+			var err error
+			info, err = q.shippingInfo(opt.Method, p.blueprint, p.provider)
+			if err != nil {
+				opt.Eligible = false
+				opt.Reasons = append(opt.Reasons, fmt.Sprintf("shipping information unavailable for blueprint %d and print provider %d: %v", p.blueprint, p.provider, err))
+			}
+			infos[p] = info
+		}
+		if info == nil {
+			continue
+		}
+
+		entry := findShipping(info, item.VariantId, country)
+		if entry == nil {
+			opt.Eligible = false
+			opt.Reasons = append(opt.Reasons, fmt.Sprintf("variant %d does not ship to %s", item.VariantId, country))
+			continue
+		}
+		if opt.Price.Currency == "" {
+			opt.Price.Currency = entry.ShippingCost.FirstItem.Currency
+		}
+		opt.HandlingTime.MinDays = max(opt.HandlingTime.MinDays, entry.HandlingTime.From)
+		opt.HandlingTime.MaxDays = max(opt.HandlingTime.MaxDays, entry.HandlingTime.To)
+	}
+}
+
+func (q *Quoter) shippingInfo(method MethodEnum, blueprintId, printProviderId int) (*catalogv2.ShippingInfo, error) {
+	switch method {
+	case MethodPriority:
+		return q.Catalog.GetShippingPriorityInfoForVariantsOfBlueprintById(blueprintId, printProviderId)
+	case MethodPrintifyExpress:
+		return q.Catalog.GetShippingExpressInfoForVariantsOfBlueprintById(blueprintId, printProviderId)
+	case MethodEconomy:
+		return q.Catalog.GetShippingEconomyInfoForVariantsOfBlueprintById(blueprintId, printProviderId)
+	default:
+		return q.Catalog.GetShippingStandardInfoForVariantsOfBlueprintById(blueprintId, printProviderId)
+	}
+}
+
+// findShipping returns the entry for the variant and country, falling back to the rest-of-the-world profile.
+func findShipping(info *catalogv2.ShippingInfo, variantId int, country string) *catalogv2.SpecificShipping {
+	var fallback *catalogv2.SpecificShipping
+	for i := range info.Data {
+		entry := &info.Data[i]
+		if entry.VariantId != variantId {
+			continue
+		}
+		if entry.Country == country {
+			return entry
+		}
+		if entry.Country == restOfTheWorld {
+			fallback = entry
+		}
+	}
+	return fallback
+}
+
+func price(costs *order.ShipmentCalculationResponse, method MethodEnum) common.Money {
+	switch method {
+	case MethodPriority:
+		return costs.Priority
+	case MethodPrintifyExpress:
+		if costs.PrintifyExpress.IsZero() {
+			return costs.Express
+		}
+		return costs.PrintifyExpress
+	case MethodEconomy:
+		return costs.Economy
+	default:
+		return costs.Standard
+	}
+}
@@ -0,0 +1,58 @@
+package quote
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+	catalogv2 "github.com/connellrobert/printify-go/pkg/v2/catalog"
+)
+
+func newQuoteTestClient(register func(mux *http.ServeMux)) (*common.Client, func()) {
+	mux := http.NewServeMux()
+	register(mux)
+	srv := httptest.NewServer(mux)
+	c := common.NewClient("printify_pat", 123)
+	c.Host = srv.URL
+	return c, srv.Close
+}
+
+func ExampleQuoter_Quote() {
+	c, closeFn := newQuoteTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/orders/shipping.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"standard":500,"priority":900,"printify_express":900,"economy":0}`))
+		})
+		handling := func(from, to int) http.HandlerFunc {
+			return func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = fmt.Fprintf(w, `{"data":[{"country":"US","variant_id":17887,"handling_time":{"from":%d,"to":%d},"shipping_cost":{"first_item":{"amount":500,"currency":"USD"}}}]}`, from, to)
+			}
+		}
+		mux.HandleFunc("/v2/catalog/blueprints/6/print_providers/99/shipping/standard.json", handling(2, 4))
+		mux.HandleFunc("/v2/catalog/blueprints/6/print_providers/99/shipping/priority.json", handling(2, 4))
+		mux.HandleFunc("/v2/catalog/blueprints/6/print_providers/99/shipping/express.json", handling(1, 1))
+		mux.HandleFunc("/v2/catalog/blueprints/6/print_providers/99/shipping/economy.json", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not found"}`))
+		})
+	})
+	defer closeFn()
+
+	q := NewQuoter(order.NewClient(c), catalogv2.NewClient(c), 123)
+	cart := []CartItem{{ProductId: "prod_1", VariantId: 17887, Quantity: 1, BlueprintId: 6, PrintProviderId: 99}}
+	options, _ := q.Quote(cart, order.Address{Country: "US"})
+	for _, opt := range options {
+		fmt.Printf("%s method=%d price=%s delivery=%d-%d eligible=%t reasons=%d\n", opt.Method, opt.ShippingMethod, opt.Price, opt.Delivery.MinDays, opt.Delivery.MaxDays, opt.Eligible, len(opt.Reasons))
+	}
+	// Output:
+	// standard method=1 price=5.00 USD delivery=5-12 eligible=true reasons=0
+	// printify_express method=3 price=9.00 USD delivery=2-4 eligible=true reasons=0
+	// priority method=2 price=9.00 USD delivery=4-9 eligible=true reasons=0
+	// economy method=4 price=0.00 delivery=5-12 eligible=false reasons=2
+}
+
+func ExampleDefaultTransitTime() {
+	fmt.Printf("%+v\n", DefaultTransitTime(MethodPrintifyExpress, "US"))
+	// Output: {MinDays:1 MaxDays:3}
+}
@@ -0,0 +1,74 @@
+package quote
+
+import (
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+)
+
+// MethodEnum names a Printify shipping method.
+type MethodEnum string
+
+const (
+	MethodStandard        MethodEnum = "standard"
+	MethodPriority        MethodEnum = "priority"
+	MethodPrintifyExpress MethodEnum = "printify_express"
+	MethodEconomy         MethodEnum = "economy"
+)
+
+// Methods lists every method a Quoter prices, in Printify's shipping_method order.
+var Methods = []MethodEnum{MethodStandard, MethodPriority, MethodPrintifyExpress, MethodEconomy}
+
+// ShippingMethod returns the order.OrderSubmission ShippingMethod value for the method.
+func (m MethodEnum) ShippingMethod() int {
+	switch m {
+	case MethodStandard:
+		return 1
+	case MethodPriority:
+		return 2
+	case MethodPrintifyExpress:
+		return 3
+	case MethodEconomy:
+		return 4
+	}
+	return 0
+}
+
+// CartItem is one product variant in the cart being quoted.
+//
+// BlueprintId and PrintProviderId are used to look up handling times in the v2 catalog.
+type CartItem struct {
+	ProductId       string
+	VariantId       int
+	Quantity        int
+	BlueprintId     int
+	PrintProviderId int
+}
+
+// Window is an inclusive range of days.
+type Window struct {
+	MinDays int
+	MaxDays int
+}
+
+// Add returns the sum of two windows.
+func (w Window) Add(other Window) Window {
+	return Window{MinDays: w.MinDays + other.MinDays, MaxDays: w.MaxDays + other.MaxDays}
+}
+
+// Option is a priced shipping choice for a cart.
+type Option struct {
+	Method MethodEnum
+	// ShippingMethod is the value to submit in order.OrderSubmission.ShippingMethod.
+	ShippingMethod int
+	// Price is the shipping price for the whole cart.
+	Price common.Money
+	// HandlingTime is the longest production time of any item in the cart.
+	HandlingTime Window
+	// Transit is the estimated time in transit once the order has shipped.
+	Transit Window
+	// Delivery is HandlingTime plus Transit.
+	Delivery Window
+	// Eligible is false when the method cannot be used for this cart and destination.
+	Eligible bool
+	// Reasons explains why the method is not eligible.
+	Reasons []string
+}
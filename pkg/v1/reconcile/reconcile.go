@@ -0,0 +1,202 @@
+package reconcile
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+const canceledStatus = "canceled"
+
+// Reconcile fetches the shop's orders matching query and compares them with the storefront's orders.
+//
+// Narrow query to the period covered by expected (for example with CreatedFrom),
+// otherwise older Printify orders are reported as Unknown.
+func Reconcile(orders order.Client, query order.ListOrdersOptions, expected []ExternalOrder, opts Options) (*Report, error) {
+	actual, err := orders.QueryOrders(query)
+	if err != nil {
+		return nil, err
+	}
+	report := Compare(expected, actual, opts)
+	return &report, nil
+}
+
+// Compare matches storefront orders with Printify orders by external id, or by
+// OrderMetadata.ShopOrderId when ExternalOrder.ShopOrderId is set, and reports
+// missing, duplicated and mismatched orders.
+func Compare(expected []ExternalOrder, actual []order.Order, opts Options) Report {
+	report := Report{
+		Matched:    []string{},
+		Missing:    []string{},
+		Duplicated: []Duplicate{},
+		Mismatched: []Mismatch{},
+		Unknown:    []string{},
+	}
+
+	byExternalId := map[string][]int{}
+	byShopOrderId := map[int][]int{}
+	for i, o := range actual {
+		if o.Status == canceledStatus && !opts.IncludeCanceled {
+			continue
+		}
+		if id := o.ExternalId(); id != "" {
+			byExternalId[id] = append(byExternalId[id], i)
+		}
+		if id := o.Metadata.ShopOrderId; id != 0 {
+			byShopOrderId[id] = append(byShopOrderId[id], i)
+		}
+	}
+
+	used := make([]bool, len(actual))
+	for _, e := range expected {
+		candidates := byExternalId[e.ExternalId]
+		if e.ShopOrderId != 0 {
+			candidates = union(candidates, byShopOrderId[e.ShopOrderId])
+		}
+		for _, i := range candidates {
+			used[i] = true
+		}
+
+		switch len(candidates) {
+		case 0:
+			report.Missing = append(report.Missing, e.ExternalId)
+		case 1:
+			o := actual[candidates[0]]
+			if diffs := differences(e, o); len(diffs) > 0 {
+				report.Mismatched = append(report.Mismatched, Mismatch{ExternalId: e.ExternalId, OrderId: o.Id, Differences: diffs})
+			} else {
+				report.Matched = append(report.Matched, e.ExternalId)
+			}
+		default:
+			d := Duplicate{ExternalId: e.ExternalId}
+			for _, i := range candidates {
+				d.OrderIds = append(d.OrderIds, actual[i].Id)
+			}
+			report.Duplicated = append(report.Duplicated, d)
+		}
+	}
+
+	for i, o := range actual {
+		if used[i] || (o.Status == canceledStatus && !opts.IncludeCanceled) {
+			continue
+		}
+		report.Unknown = append(report.Unknown, o.Id)
+	}
+	return report
+}
+
+func differences(e ExternalOrder, o order.Order) []Difference {
+	var diffs []Difference
+	diffs = append(diffs, lineItemDifferences(e.LineItems, o.LineItems)...)
+	if !e.TotalPrice.IsZero() && e.TotalPrice.Amount != o.TotalPrice.Amount {
+		diffs = append(diffs, Difference{Field: "total_price", Expected: e.TotalPrice.Decimal(), Actual: o.TotalPrice.Decimal()})
+	}
+	diffs = append(diffs, addressDifferences(e.AddressTo, o.AddressTo)...)
+	return diffs
+}
+
+func lineItemDifferences(expected []ExpectedLineItem, actual []order.LineItem) []Difference {
+	var (
+		keys          []string
+		expectedQty   = map[string]int{}
+		actualQty     = map[string]int{}
+		expectedSku   = map[string]bool{}
+		expectedPairs = map[string]bool{}
+	)
+	for _, item := range expected {
+		key := item.Sku
+		if key == "" {
+			key = variantKey(item.ProductId, item.VariantId)
+			expectedPairs[key] = true
+		} else {
+			expectedSku[key] = true
+		}
+		if _, ok := expectedQty[key]; !ok {
+			keys = append(keys, key)
+		}
+		expectedQty[key] += item.Quantity
+	}
+
+	for _, li := range actual {
+		switch pair := variantKey(li.ProductId, li.VariantId); {
+		case li.Metadata.Sku != "" && expectedSku[li.Metadata.Sku]:
+			actualQty[li.Metadata.Sku] += li.Quantity
+		case expectedPairs[pair]:
+			actualQty[pair] += li.Quantity
+		default:
+			key := li.Metadata.Sku
+			if key == "" {
+				key = pair
+			}
+			if _, ok := actualQty[key]; !ok {
+				keys = append(keys, key)
+			}
+			actualQty[key] += li.Quantity
+		}
+	}
+
+	var diffs []Difference
+	for _, key := range keys {
+		if expectedQty[key] != actualQty[key] {
+			diffs = append(diffs, Difference{
+				Field:    fmt.Sprintf("quantity[%s]", key),
+				Expected: strconv.Itoa(expectedQty[key]),
+				Actual:   strconv.Itoa(actualQty[key]),
+			})
+		}
+	}
+	return diffs
+}
+
+// addressDifferences compares the fields set in expected, ignoring case and surrounding whitespace.
+func addressDifferences(expected, actual order.Address) []Difference {
+	fields := []struct {
+		name             string
+		expected, actual string
+	}{
+		{"first_name", expected.FirstName, actual.FirstName},
+		{"last_name", expected.LastName, actual.LastName},
+		{"address1", expected.Address1, actual.Address1},
+		{"address2", expected.Address2, actual.Address2},
+		{"city", expected.City, actual.City},
+		{"region", expected.Region, actual.Region},
+		{"zip", expected.Zip, actual.Zip},
+		{"country", expected.Country, actual.Country},
+		{"email", expected.Email, actual.Email},
+		{"phone", expected.Phone, actual.Phone},
+		{"company", expected.Company, actual.Company},
+	}
+	var diffs []Difference
+	for _, f := range fields {
+		if strings.TrimSpace(f.expected) == "" {
+			continue
+		}
+		if !strings.EqualFold(strings.TrimSpace(f.expected), strings.TrimSpace(f.actual)) {
+			diffs = append(diffs, Difference{Field: "address_to." + f.name, Expected: f.expected, Actual: f.actual})
+		}
+	}
+	return diffs
+}
+
+func variantKey(productId, variantId string) string {
+	return productId + "/" + variantId
+}
+
+func union(a, b []int) []int {
+	out := append([]int(nil), a...)
+	for _, i := range b {
+		found := false
+		for _, j := range out {
+			if i == j {
+				found = true
+				break
+			}
+		}
+		if !found {
+			out = append(out, i)
+		}
+	}
+	return out
+}
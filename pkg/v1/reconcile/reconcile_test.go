@@ -0,0 +1,121 @@
+package reconcile
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+	v1common "github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+func newReconcileTestClient(register func(mux *http.ServeMux)) (*common.Client, func()) {
+	mux := http.NewServeMux()
+	register(mux)
+	srv := httptest.NewServer(mux)
+	c := common.NewClient("printify_pat", 123)
+	c.Host = srv.URL
+	return c, srv.Close
+}
+
+var exampleExpected = []ExternalOrder{
+	{ExternalId: "EXT-1", LineItems: []ExpectedLineItem{{Sku: "TEE-BLK-M", Quantity: 1}}},
+	{ExternalId: "EXT-2", LineItems: []ExpectedLineItem{{Sku: "MUG-11", Quantity: 2}}, TotalPrice: v1common.NewMoney(2000, "")},
+	{ExternalId: "EXT-3", LineItems: []ExpectedLineItem{{Sku: "TEE-BLK-M", Quantity: 1}}},
+	{ExternalId: "EXT-4", LineItems: []ExpectedLineItem{{Sku: "TEE-BLK-M", Quantity: 1}}},
+}
+
+func exampleOrder(id, externalId, status, sku string, quantity, totalPrice int) order.Order {
+	return order.Order{
+		Id:         id,
+		Status:     status,
+		Metadata:   order.OrderMetadata{ShopOrderLabel: externalId},
+		TotalPrice: v1common.NewMoney(totalPrice, ""),
+		LineItems:  []order.LineItem{{Quantity: quantity, Metadata: order.LineItemMetadata{Sku: sku}}},
+	}
+}
+
+func ExampleCompare() {
+	actual := []order.Order{
+		exampleOrder("ord_1", "EXT-1", "fulfilled", "TEE-BLK-M", 1, 2500),
+		exampleOrder("ord_2", "EXT-2", "on-hold", "MUG-11", 1, 1000),
+		exampleOrder("ord_3", "EXT-3", "on-hold", "TEE-BLK-M", 1, 2500),
+		exampleOrder("ord_4", "EXT-3", "on-hold", "TEE-BLK-M", 1, 2500),
+		exampleOrder("ord_5", "EXT-4", "canceled", "TEE-BLK-M", 1, 2500),
+		exampleOrder("ord_6", "EXT-9", "on-hold", "TEE-BLK-M", 1, 2500),
+	}
+
+	report := Compare(exampleExpected, actual, Options{})
+	out, _ := json.MarshalIndent(report, "", "  ")
+	fmt.Println(string(out))
+	// Output:
+	// {
+	//   "matched": [
+	//     "EXT-1"
+	//   ],
+	//   "missing": [
+	//     "EXT-4"
+	//   ],
+	//   "duplicated": [
+	//     {
+	//       "external_id": "EXT-3",
+	//       "order_ids": [
+	//         "ord_3",
+	//         "ord_4"
+	//       ]
+	//     }
+	//   ],
+	//   "mismatched": [
+	//     {
+	//       "external_id": "EXT-2",
+	//       "order_id": "ord_2",
+	//       "differences": [
+	//         {
+	//           "field": "quantity[MUG-11]",
+	//           "expected": "2",
+	//           "actual": "1"
+	//         },
+	//         {
+	//           "field": "total_price",
+	//           "expected": "20.00",
+	//           "actual": "10.00"
+	//         }
+	//       ]
+	//     }
+	//   ],
+	//   "unknown": [
+	//     "ord_6"
+	//   ]
+	// }
+}
+
+func ExampleReconcile() {
+	c, closeFn := newReconcileTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/orders.json", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"data":[{"id":"ord_1","metadata":{"shop_order_label":"EXT-1"},"line_items":[{"quantity":1,"metadata":{"sku":"TEE-BLK-M"}}]}]}`))
+		})
+	})
+	defer closeFn()
+
+	report, _ := Reconcile(order.NewClient(c), order.ListOrdersOptions{}, exampleExpected[:2], Options{})
+	fmt.Println(report.Matched, report.Missing)
+	// Output: [EXT-1] [EXT-2]
+}
+
+func ExampleCompare_partialAddress() {
+	o := exampleOrder("ord_1", "EXT-1", "on-hold", "TEE-BLK-M", 1, 2500)
+	o.AddressTo = order.Address{FirstName: "Ada", City: "Springfield", Country: "US", Email: "ada@example.com"}
+
+	expected := []ExternalOrder{
+		{ExternalId: "EXT-1", LineItems: []ExpectedLineItem{{Sku: "TEE-BLK-M", Quantity: 1}}, AddressTo: order.Address{Country: "us"}},
+	}
+	fmt.Println(Compare(expected, []order.Order{o}, Options{}).Matched)
+
+	expected[0].AddressTo = order.Address{Email: "grace@example.com"}
+	fmt.Println(Compare(expected, []order.Order{o}, Options{}).Mismatched[0].Differences)
+	// Output:
+	// [EXT-1]
+	// [{address_to.email grace@example.com ada@example.com}]
+}
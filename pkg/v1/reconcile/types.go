@@ -0,0 +1,71 @@
+package reconcile
+
+import (
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+// ExternalOrder is an order as recorded by the storefront.
+type ExternalOrder struct {
+	// ExternalId is the id submitted to Printify as OrderSubmission.ExternalId.
+	ExternalId string
+	// ShopOrderId optionally matches OrderMetadata.ShopOrderId for orders imported by a sales channel integration.
+	ShopOrderId int
+	LineItems   []ExpectedLineItem
+	// TotalPrice is compared with Order.TotalPrice when non-zero.
+	TotalPrice common.Money
+	// AddressTo is compared with Order.AddressTo field by field; empty fields are not compared.
+	AddressTo order.Address
+}
+
+// ExpectedLineItem is a line item the storefront expects to find on the Printify order.
+//
+// Items are matched by Sku when set, otherwise by ProductId and VariantId.
+type ExpectedLineItem struct {
+	ProductId string
+	VariantId string
+	Sku       string
+	Quantity  int
+}
+
+// Options tunes how orders are compared.
+type Options struct {
+	// IncludeCanceled counts canceled Printify orders. By default they are ignored, so an
+	// order that was canceled and resubmitted is not reported as a duplicate.
+	IncludeCanceled bool
+}
+
+// Report is the machine-readable result of a reconciliation.
+type Report struct {
+	// Matched lists external ids that have exactly one Printify order with no differences.
+	Matched []string `json:"matched"`
+	// Missing lists external ids with no Printify order.
+	Missing []string `json:"missing"`
+	// Duplicated lists external ids that reached Printify more than once.
+	Duplicated []Duplicate `json:"duplicated"`
+	// Mismatched lists orders whose line items, totals or address differ from the storefront.
+	Mismatched []Mismatch `json:"mismatched"`
+	// Unknown lists Printify order ids whose external id is not in the storefront list.
+	Unknown []string `json:"unknown"`
+}
+
+// Duplicate describes an external order that was submitted more than once.
+type Duplicate struct {
+	ExternalId string   `json:"external_id"`
+	OrderIds   []string `json:"order_ids"`
+}
+
+// Mismatch describes the differences between a storefront order and its Printify order.
+type Mismatch struct {
+	ExternalId  string       `json:"external_id"`
+	OrderId     string       `json:"order_id"`
+	Differences []Difference `json:"differences"`
+}
+
+// Difference is a single field that does not agree.
+type Difference struct {
+	// Field names what differs, for example "quantity[TEE-BLK-M]", "total_price" or "address_to.zip".
+	Field    string `json:"field"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
@@ -0,0 +1,245 @@
+package render
+
+import (
+	htmltemplate "html/template"
+	"io"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+	"github.com/connellrobert/printify-go/pkg/v1/tracking"
+)
+
+// Renderer renders orders with templates.
+type Renderer struct {
+	// Products optionally looks up products for titles and mockup images.
+	Products ProductLookup
+	// Currency is applied to amounts Printify reports without a currency.
+	Currency string
+	// Data is passed to templates as Document.Data.
+	Data any
+}
+
+// NewRenderer creates a Renderer for amounts in currency.
+func NewRenderer(currency string) *Renderer {
+	return &Renderer{Currency: currency}
+}
+
+// Render builds the Document for o and executes t with it.
+func (r *Renderer) Render(w io.Writer, t Template, o order.Order) error {
+	doc, err := r.Document(o)
+	if err != nil {
+		return err
+	}
+	return t.Execute(w, doc)
+}
+
+// Document prepares the template data for an order.
+//
+// Line titles and variant labels come from the order, falling back to the looked
+// up product. The error is only set when the ProductLookup fails.
+func (r *Renderer) Document(o order.Order) (Document, error) {
+	doc := Document{
+		Order:      o,
+		OrderId:    o.Id,
+		ExternalId: o.ExternalId(),
+		CreatedAt:  o.CreatedAt,
+		AddressTo:  o.AddressTo,
+		Lines:      make([]Line, 0, len(o.LineItems)),
+		Subtotal:   r.money(common.Money{}),
+		Shipping:   r.money(o.TotalShipping),
+		Tax:        r.money(o.TotalTax),
+		Delivery:   tracking.Summarize(o),
+		Data:       r.Data,
+	}
+
+	products := map[string]*product.Product{}
+	for _, li := range o.LineItems {
+		line := Line{
+			LineItem:     li,
+			Title:        li.Metadata.Title,
+			VariantLabel: li.Metadata.VariantLabel,
+			Sku:          li.Metadata.Sku,
+			UnitPrice:    r.money(li.Metadata.Price),
+		}
+		line.Total = line.UnitPrice.Mul(li.Quantity)
+
+		if r.Products != nil && li.ProductId != "" {
+			p, ok := products[li.ProductId]
+			if !ok {
+				var err error
+				if p, err = r.Products(li.ProductId); err != nil {
+					return Document{}, err
+				}
+				products[li.ProductId] = p
+			}
+			if p != nil {
+				line.Product = p
+				enrich(&line, p)
+			}
+		}
+
+		doc.Subtotal.Amount += line.Total.Amount
+		doc.Lines = append(doc.Lines, line)
+	}
+	doc.Total = r.money(common.Money{Amount: doc.Subtotal.Amount + doc.Shipping.Amount + doc.Tax.Amount})
+	return doc, nil
+}
+
+// LookupFromClient returns a ProductLookup that fetches products from a shop.
+func LookupFromClient(products product.Client, shopId int) ProductLookup {
+	return func(productId string) (*product.Product, error) {
+		return products.GetProduct(shopId, productId)
+	}
+}
+
+func (r *Renderer) money(m common.Money) common.Money {
+	if m.Currency == "" {
+		m.Currency = r.Currency
+	}
+	return m
+}
+
+func enrich(line *Line, p *product.Product) {
+	variantId, _ := strconv.Atoi(line.VariantId)
+	if line.Title == "" {
+		line.Title = p.Title
+	}
+	for _, v := range p.Variants {
+		if v.Id != variantId {
+			continue
+		}
+		if line.VariantLabel == "" {
+			line.VariantLabel = v.Title
+		}
+		if line.Sku == "" {
+			line.Sku = v.Sku
+		}
+	}
+
+	var fallback string
+	for _, img := range p.Images {
+		for _, id := range img.VariantIds {
+			if id != variantId {
+				continue
+			}
+			if img.IsDefault {
+				line.ImageUrl = img.Src
+				return
+			}
+			if fallback == "" {
+				fallback = img.Src
+			}
+		}
+	}
+	line.ImageUrl = fallback
+}
+
+var currencySymbols = map[string]string{
+	"USD": "$",
+	"EUR": "€",
+	"GBP": "£",
+	"CAD": "CA$",
+	"AUD": "A$",
+}
+
+// FormatMoney formats an amount for customers, for example "$12.50" or "12.50 SEK".
+func FormatMoney(m common.Money) string {
+	symbol, ok := currencySymbols[m.Currency]
+	if !ok {
+		return m.String()
+	}
+	if m.Amount < 0 {
+		return "-" + symbol + common.NewMoney(-m.Amount, m.Currency).Decimal()
+	}
+	return symbol + m.Decimal()
+}
+
+// AddressLines formats an address as postal lines, leaving out empty parts.
+func AddressLines(a order.Address) []string {
+	var lines []string
+	add := func(parts ...string) {
+		var kept []string
+		for _, p := range parts {
+			if p = strings.TrimSpace(p); p != "" {
+				kept = append(kept, p)
+			}
+		}
+		if len(kept) > 0 {
+			lines = append(lines, strings.Join(kept, " "))
+		}
+	}
+	add(a.FirstName, a.LastName)
+	add(a.Company)
+	add(a.Address1)
+	add(a.Address2)
+	locality := strings.TrimSpace(a.City)
+	if region := strings.TrimSpace(a.Region); region != "" {
+		if locality != "" {
+			locality += ","
+		}
+		locality += " " + region
+	}
+	add(locality, a.Zip)
+	add(a.Country)
+	return lines
+}
+
+// FormatAddress formats an address on a single line.
+func FormatAddress(a order.Address) string {
+	return strings.Join(AddressLines(a), ", ")
+}
+
+// Funcs returns the helpers available in every template parsed by this package:
+//
+//	money        FormatMoney
+//	address      FormatAddress
+//	addressLines AddressLines
+//	date         formats a common.Timestamp with a time layout
+func Funcs() map[string]any {
+	return map[string]any{
+		"money":        FormatMoney,
+		"address":      FormatAddress,
+		"addressLines": AddressLines,
+		"date": func(layout string, t common.Timestamp) string {
+			if t.IsZero() {
+				return ""
+			}
+			return t.Format(layout)
+		},
+	}
+}
+
+// ParseText parses a text/template with Funcs.
+func ParseText(name, src string) (*texttemplate.Template, error) {
+	return texttemplate.New(name).Funcs(Funcs()).Parse(src)
+}
+
+// ParseHTML parses an html/template with Funcs.
+func ParseHTML(name, src string) (*htmltemplate.Template, error) {
+	return htmltemplate.New(name).Funcs(Funcs()).Parse(src)
+}
+
+// ParseTextFiles parses text/template files with Funcs. The template is named after the first file.
+func ParseTextFiles(filenames ...string) (*texttemplate.Template, error) {
+	return texttemplate.New(baseName(filenames)).Funcs(Funcs()).ParseFiles(filenames...)
+}
+
+// ParseHTMLFiles parses html/template files with Funcs. The template is named after the first file.
+func ParseHTMLFiles(filenames ...string) (*htmltemplate.Template, error) {
+	return htmltemplate.New(baseName(filenames)).Funcs(Funcs()).ParseFiles(filenames...)
+}
+
+func baseName(filenames []string) string {
+	if len(filenames) == 0 {
+		return ""
+	}
+	name := filenames[0]
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
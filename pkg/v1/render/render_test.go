@@ -0,0 +1,109 @@
+package render
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+)
+
+func exampleOrder() order.Order {
+	created, _ := common.ParseTimestamp("2024-05-01 09:30:00+00:00")
+	return order.Order{
+		Id:        "5a96f649b2439217d070f507",
+		Metadata:  order.OrderMetadata{ShopOrderLabel: "#1042"},
+		CreatedAt: created,
+		AddressTo: order.Address{
+			FirstName: "Ada", LastName: "Lovelace", Address1: "1 Main St", Address2: "Apt 2",
+			City: "Springfield", Region: "IL", Zip: "62701", Country: "US",
+		},
+		LineItems: []order.LineItem{
+			{ProductId: "prod_1", VariantId: "17887", Quantity: 2, Metadata: order.LineItemMetadata{
+				Price: common.NewMoney(2500, ""), VariantLabel: "Black / M", Sku: "TEE-BLK-M",
+			}},
+			{ProductId: "prod_2", VariantId: "12100", Quantity: 1, Metadata: order.LineItemMetadata{
+				Title: "Mug", Price: common.NewMoney(1500, ""),
+			}},
+		},
+		TotalShipping: common.NewMoney(599, ""),
+		TotalTax:      common.NewMoney(420, ""),
+		Status:        "in-production",
+	}
+}
+
+func exampleLookup(productId string) (*product.Product, error) {
+	switch productId {
+	case "prod_1":
+		return &product.Product{Id: "prod_1", Title: "Classic Tee", Images: []product.MockupImage{
+			{Src: "https://images.example/tee-back.png", VariantIds: []int{17887}},
+			{Src: "https://images.example/tee-front.png", VariantIds: []int{17887}, IsDefault: true},
+		}}, nil
+	case "prod_2":
+		return &product.Product{Id: "prod_2", Title: "White Mug 11oz", Variants: []product.Variant{{Id: 12100, Title: "11oz"}}}, nil
+	}
+	return nil, nil
+}
+
+func ExampleRenderer_Render_packingSlip() {
+	r := NewRenderer("USD")
+	r.Products = exampleLookup
+	_ = r.Render(os.Stdout, PackingSlipText, exampleOrder())
+	// Output:
+	// PACKING SLIP
+	// Order: #1042
+	// Date: May 1, 2024
+	//
+	// Ship to:
+	//   Ada Lovelace
+	//   1 Main St
+	//   Apt 2
+	//   Springfield, IL 62701
+	//   US
+	//
+	// Items:
+	//   2 x Classic Tee (Black / M) [TEE-BLK-M]
+	//   1 x Mug (11oz)
+}
+
+func ExampleRenderer_Render_confirmation() {
+	r := NewRenderer("USD")
+	r.Products = exampleLookup
+	_ = r.Render(os.Stdout, ConfirmationText, exampleOrder())
+	// Output:
+	// Thank you for your order!
+	//
+	// Order: #1042
+	//
+	// 2 x Classic Tee (Black / M)  $50.00
+	// 1 x Mug (11oz)  $15.00
+	//
+	// Subtotal: $65.00
+	// Shipping: $5.99
+	// Tax: $4.20
+	// Total: $75.19
+	//
+	// Shipping to: Ada Lovelace, 1 Main St, Apt 2, Springfield, IL 62701, US
+	// Your order is being prepared.
+}
+
+func ExampleRenderer_Document() {
+	r := NewRenderer("GBP")
+	r.Products = exampleLookup
+	doc, _ := r.Document(exampleOrder())
+	for _, line := range doc.Lines {
+		fmt.Println(line.Title, FormatMoney(line.UnitPrice), line.ImageUrl)
+	}
+	// Output:
+	// Classic Tee £25.00 https://images.example/tee-front.png
+	// Mug £15.00
+}
+
+func ExampleParseHTML() {
+	t, _ := ParseHTML("custom", `<p>{{.Data}}: {{money .Total}}</p>`)
+	r := NewRenderer("EUR")
+	r.Data = "Total <incl. VAT>"
+	_ = r.Render(os.Stdout, t, exampleOrder())
+	// Output: <p>Total &lt;incl. VAT&gt;: €75.19</p>
+}
@@ -0,0 +1,95 @@
+package render
+
+import (
+	htmltemplate "html/template"
+	texttemplate "text/template"
+)
+
+// Sources of the built-in templates. They can be copied as a starting point for custom templates.
+const (
+	PackingSlipTextSource = `PACKING SLIP
+Order: {{or .ExternalId .OrderId}}
+{{- with date "January 2, 2006" .CreatedAt}}
+Date: {{.}}
+{{- end}}
+
+Ship to:
+{{- range addressLines .AddressTo}}
+  {{.}}
+{{- end}}
+
+Items:
+{{- range .Lines}}
+  {{.Quantity}} x {{.Title}}{{with .VariantLabel}} ({{.}}){{end}}{{with .Sku}} [{{.}}]{{end}}
+{{- end}}
+`
+
+	ConfirmationTextSource = `Thank you for your order!
+
+Order: {{or .ExternalId .OrderId}}
+{{range .Lines}}
+{{.Quantity}} x {{.Title}}{{with .VariantLabel}} ({{.}}){{end}}  {{money .Total}}
+{{- end}}
+
+Subtotal: {{money .Subtotal}}
+Shipping: {{money .Shipping}}
+{{- if .Tax.Amount}}
+Tax: {{money .Tax}}
+{{- end}}
+Total: {{money .Total}}
+
+Shipping to: {{address .AddressTo}}
+{{.Delivery.Message}}
+`
+
+	PackingSlipHTMLSource = `<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Packing slip {{or .ExternalId .OrderId}}</title></head>
+<body>
+<h1>Packing slip</h1>
+<p>Order {{or .ExternalId .OrderId}}{{with date "January 2, 2006" .CreatedAt}}, {{.}}{{end}}</p>
+<h2>Ship to</h2>
+<address>
+{{- range $i, $line := addressLines .AddressTo}}{{if $i}}<br>{{end}}{{$line}}{{end -}}
+</address>
+<table>
+<tr><th></th><th>Item</th><th>SKU</th><th>Qty</th></tr>
+{{- range .Lines}}
+<tr><td>{{with .ImageUrl}}<img src="{{.}}" width="80" alt="">{{end}}</td><td>{{.Title}}{{with .VariantLabel}}<br><small>{{.}}</small>{{end}}</td><td>{{.Sku}}</td><td>{{.Quantity}}</td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`
+
+	ConfirmationHTMLSource = `<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Order {{or .ExternalId .OrderId}}</title></head>
+<body>
+<h1>Thank you for your order!</h1>
+<p>Order {{or .ExternalId .OrderId}}</p>
+<table>
+{{- range .Lines}}
+<tr><td>{{with .ImageUrl}}<img src="{{.}}" width="80" alt="">{{end}}</td><td>{{.Title}}{{with .VariantLabel}}<br><small>{{.}}</small>{{end}}</td><td>{{.Quantity}}</td><td>{{money .Total}}</td></tr>
+{{- end}}
+<tr><td colspan="3">Subtotal</td><td>{{money .Subtotal}}</td></tr>
+<tr><td colspan="3">Shipping</td><td>{{money .Shipping}}</td></tr>
+{{- if .Tax.Amount}}
+<tr><td colspan="3">Tax</td><td>{{money .Tax}}</td></tr>
+{{- end}}
+<tr><th colspan="3">Total</th><th>{{money .Total}}</th></tr>
+</table>
+<p>Shipping to {{address .AddressTo}}</p>
+<p>{{.Delivery.Message}}</p>
+</body>
+</html>
+`
+)
+
+// Built-in templates, parsed from the sources above.
+var (
+	PackingSlipText  = texttemplate.Must(ParseText("packing_slip.txt", PackingSlipTextSource))
+	ConfirmationText = texttemplate.Must(ParseText("confirmation.txt", ConfirmationTextSource))
+	PackingSlipHTML  = htmltemplate.Must(ParseHTML("packing_slip.html", PackingSlipHTMLSource))
+	ConfirmationHTML = htmltemplate.Must(ParseHTML("confirmation.html", ConfirmationHTMLSource))
+)
@@ -0,0 +1,56 @@
+package render
+
+import (
+	"io"
+
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+	"github.com/connellrobert/printify-go/pkg/v1/product"
+	"github.com/connellrobert/printify-go/pkg/v1/tracking"
+)
+
+// Template is implemented by both *text/template.Template and *html/template.Template.
+type Template interface {
+	Execute(w io.Writer, data any) error
+}
+
+// ProductLookup returns the product with the given id, used to enrich line items
+// with titles and mockup images.
+type ProductLookup func(productId string) (*product.Product, error)
+
+// Document is the data passed to templates.
+type Document struct {
+	Order order.Order
+	// OrderId is the Printify order id.
+	OrderId string
+	// ExternalId is the sales channel order id, see order.Order.ExternalId.
+	ExternalId string
+	CreatedAt  common.Timestamp
+	AddressTo  order.Address
+	Lines      []Line
+	// Subtotal is the sum of every line's Total.
+	Subtotal common.Money
+	Shipping common.Money
+	Tax      common.Money
+	// Total is Subtotal plus Shipping and Tax.
+	Total common.Money
+	// Delivery summarizes the order's shipments.
+	Delivery tracking.Summary
+	// Data is passed through from Renderer.Data for use in custom templates.
+	Data any
+}
+
+// Line is a line item prepared for display.
+type Line struct {
+	order.LineItem
+	// Product is the looked up product, or nil without a ProductLookup.
+	Product      *product.Product
+	Title        string
+	VariantLabel string
+	Sku          string
+	// ImageUrl is the variant's mockup image, when a product was looked up.
+	ImageUrl  string
+	UnitPrice common.Money
+	// Total is UnitPrice multiplied by Quantity.
+	Total common.Money
+}
@@ -0,0 +1,270 @@
+package tracking
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+type carrier struct {
+	name string
+	// aliases are lower case names with everything but letters and digits removed.
+	aliases []string
+	// urlFormat has a single %s for the escaped tracking number.
+	urlFormat string
+	numbers   []*regexp.Regexp
+}
+
+var carriers = map[CarrierEnum]carrier{
+	CarrierUSPS: {
+		name:      "USPS",
+		aliases:   []string{"usps", "unitedstatespostalservice", "uspostalservice", "usmail"},
+		urlFormat: "https://tools.usps.com/go/TrackConfirmAction?tLabels=%s",
+		numbers: []*regexp.Regexp{
+			regexp.MustCompile(`^9[1-5]\d{20,24}$`),
+			regexp.MustCompile(`^420\d{5}(\d{4})?9\d{21}$`),
+			regexp.MustCompile(`^[A-Z]{2}\d{9}US$`),
+		},
+	},
+	CarrierUPS: {
+		name:      "UPS",
+		aliases:   []string{"ups", "unitedparcelservice"},
+		urlFormat: "https://www.ups.com/track?tracknum=%s",
+		numbers: []*regexp.Regexp{
+			regexp.MustCompile(`^1Z[0-9A-Z]{16}$`),
+			regexp.MustCompile(`^T\d{10}$`),
+			regexp.MustCompile(`^\d{9}$`),
+		},
+	},
+	CarrierFedEx: {
+		name:      "FedEx",
+		aliases:   []string{"fedex", "federalexpress"},
+		urlFormat: "https://www.fedex.com/fedextrack/?trknbr=%s",
+		numbers: []*regexp.Regexp{
+			regexp.MustCompile(`^(\d{12}|\d{15}|\d{20}|\d{22})$`),
+		},
+	},
+	CarrierDHL: {
+		name:      "DHL Express",
+		aliases:   []string{"dhl", "dhlexpress"},
+		urlFormat: "https://www.dhl.com/global-en/home/tracking/tracking-express.html?submit=1&tracking-id=%s",
+		numbers: []*regexp.Regexp{
+			regexp.MustCompile(`^\d{10,11}$`),
+			regexp.MustCompile(`^JJD\d{18,20}$`),
+		},
+	},
+	CarrierDHLECommerce: {
+		name:      "DHL eCommerce",
+		aliases:   []string{"dhlecommerce", "dhlecs", "dhlglobalmail", "dhlparcel"},
+		urlFormat: "https://webtrack.dhlecs.com/orders?trackingNumber=%s",
+		numbers: []*regexp.Regexp{
+			regexp.MustCompile(`^GM\d{16,18}$`),
+			regexp.MustCompile(`^\d{16,34}$`),
+		},
+	},
+	CarrierRoyalMail: {
+		name:      "Royal Mail",
+		aliases:   []string{"royalmail"},
+		urlFormat: "https://www.royalmail.com/track-your-item#/tracking-results/%s",
+		numbers: []*regexp.Regexp{
+			regexp.MustCompile(`^[A-Z]{2}\d{9}GB$`),
+		},
+	},
+	CarrierCanadaPost: {
+		name:      "Canada Post",
+		aliases:   []string{"canadapost", "postescanada"},
+		urlFormat: "https://www.canadapost-postescanada.ca/track-reperage/en#/search?searchFor=%s",
+		numbers: []*regexp.Regexp{
+			regexp.MustCompile(`^(\d{12}|\d{16})$`),
+			regexp.MustCompile(`^[A-Z]{2}\d{9}CA$`),
+		},
+	},
+	CarrierAustraliaPost: {
+		name:      "Australia Post",
+		aliases:   []string{"australiapost", "auspost"},
+		urlFormat: "https://auspost.com.au/mypost/track/#/details/%s",
+		numbers: []*regexp.Regexp{
+			regexp.MustCompile(`^[A-Z0-9]{10,23}$`),
+		},
+	},
+	CarrierAsendia: {
+		name:      "Asendia",
+		aliases:   []string{"asendia"},
+		urlFormat: "https://a1.asendiausa.com/tracking/?trackingnumber=%s",
+		numbers: []*regexp.Regexp{
+			regexp.MustCompile(`^[A-Z0-9]{10,34}$`),
+		},
+	},
+}
+
+// genericNumber is the format accepted for carriers that are not recognized.
+var genericNumber = regexp.MustCompile(`^[A-Z0-9]{6,40}$`)
+
+type alias struct {
+	alias   string
+	carrier CarrierEnum
+}
+
+// aliases lists every carrier alias, longest first, so "dhlecommerce" wins over "dhl".
+var aliases = func() []alias {
+	var out []alias
+	for code, c := range carriers {
+		for _, a := range c.aliases {
+			out = append(out, alias{a, code})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if len(out[i].alias) != len(out[j].alias) {
+			return len(out[i].alias) > len(out[j].alias)
+		}
+		return out[i].alias < out[j].alias
+	})
+	return out
+}()
+
+// NormalizeCarrier maps a free-text carrier name, such as "U.S.P.S." or
+// "FedEx Ground", to its canonical code.
+//
+// Names are compared ignoring case, spaces and punctuation. A name containing a
+// known alias, for example "USPS First Class", matches that carrier.
+func NormalizeCarrier(name string) CarrierEnum {
+	key := squash(name)
+	if key == "" {
+		return CarrierUnknown
+	}
+	for _, a := range aliases {
+		if key == a.alias {
+			return a.carrier
+		}
+	}
+	for _, a := range aliases {
+		if strings.Contains(key, a.alias) {
+			return a.carrier
+		}
+	}
+	return CarrierUnknown
+}
+
+// NormalizeNumber removes spaces and dashes from a tracking number and upper-cases it.
+func NormalizeNumber(number string) string {
+	return strings.ToUpper(strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '\t' {
+			return -1
+		}
+		return r
+	}, number))
+}
+
+// ValidNumber reports whether number has a known format for the carrier.
+//
+// Numbers for CarrierUnknown are accepted when they are 6 to 40 letters and digits.
+func ValidNumber(c CarrierEnum, number string) bool {
+	number = NormalizeNumber(number)
+	info, ok := carriers[c]
+	if !ok {
+		return genericNumber.MatchString(number)
+	}
+	for _, re := range info.numbers {
+		if re.MatchString(number) {
+			return true
+		}
+	}
+	return false
+}
+
+// URL returns the carrier's tracking page for number, or "" for CarrierUnknown.
+func URL(c CarrierEnum, number string) string {
+	info, ok := carriers[c]
+	if !ok || number == "" {
+		return ""
+	}
+	return fmt.Sprintf(info.urlFormat, url.QueryEscape(NormalizeNumber(number)))
+}
+
+// Normalize resolves a shipment's carrier, validates its number and builds a
+// tracking URL when Printify did not report one.
+func Normalize(s order.Shipment) Tracking {
+	code := NormalizeCarrier(s.Carrier)
+	t := Tracking{
+		Carrier:     code,
+		CarrierName: strings.TrimSpace(s.Carrier),
+		Number:      NormalizeNumber(s.Number),
+		Url:         strings.TrimSpace(s.Url),
+		DeliveredAt: s.DeliveredAt,
+	}
+	if code != CarrierUnknown {
+		t.CarrierName = code.Name()
+	}
+	t.Valid = t.Number != "" && ValidNumber(code, t.Number)
+	if t.Url == "" {
+		t.Url = URL(code, t.Number)
+	}
+	return t
+}
+
+// Summarize normalizes an order's shipments and derives a customer-facing delivery status.
+func Summarize(o order.Order) Summary {
+	s := Summary{OrderId: o.Id, Shipments: make([]Tracking, 0, len(o.Shipments))}
+	for _, sh := range o.Shipments {
+		t := Normalize(sh)
+		if t.Delivered() {
+			s.Delivered++
+			if t.DeliveredAt.After(s.LastDeliveredAt.Time) {
+				s.LastDeliveredAt = t.DeliveredAt
+			}
+		}
+		s.Shipments = append(s.Shipments, t)
+	}
+
+	partial := o.Status == "partially-fulfilled"
+	switch {
+	case o.Status == "canceled":
+		s.Status, s.Message = StatusCanceled, "Your order was canceled."
+	case len(s.Shipments) == 0:
+		s.Status, s.Message = StatusProcessing, "Your order is being prepared."
+	case s.Delivered == len(s.Shipments) && !partial:
+		s.Status = StatusDelivered
+		s.Message = "Delivered on " + s.LastDeliveredAt.Format("January 2, 2006") + "."
+	case s.Delivered > 0:
+		s.Status = StatusPartiallyDelivered
+		s.Message = fmt.Sprintf("%d of %d packages delivered.", s.Delivered, len(s.Shipments))
+		if partial {
+			s.Message += " Some items are still being prepared."
+		}
+	case partial:
+		s.Status = StatusPartiallyShipped
+		s.Message = "Part of your order has shipped" + carrierSuffix(s.Shipments) + ". Some items are still being prepared."
+	default:
+		s.Status = StatusInTransit
+		s.Message = "Your order is on its way" + carrierSuffix(s.Shipments) + "."
+	}
+	return s
+}
+
+// carrierSuffix names the carrier when every shipment uses the same one.
+func carrierSuffix(shipments []Tracking) string {
+	name := shipments[0].CarrierName
+	for _, t := range shipments[1:] {
+		if t.CarrierName != name {
+			return ""
+		}
+	}
+	if name == "" {
+		return ""
+	}
+	return " with " + name
+}
+
+func squash(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,79 @@
+package tracking
+
+import (
+	"fmt"
+
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+func timestamp(s string) common.Timestamp {
+	t, err := common.ParseTimestamp(s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func ExampleNormalizeCarrier() {
+	for _, name := range []string{"U.S.P.S.", "usps first class", "FedEx Ground", "DHL eCommerce", "dhl", "Royal Mail", "Postes Canada", "Pony Express"} {
+		fmt.Printf("%q -> %q\n", name, NormalizeCarrier(name))
+	}
+	// Output:
+	// "U.S.P.S." -> "USPS"
+	// "usps first class" -> "USPS"
+	// "FedEx Ground" -> "FEDEX"
+	// "DHL eCommerce" -> "DHL_ECOMMERCE"
+	// "dhl" -> "DHL"
+	// "Royal Mail" -> "ROYAL_MAIL"
+	// "Postes Canada" -> "CANADA_POST"
+	// "Pony Express" -> ""
+}
+
+func ExampleValidNumber() {
+	fmt.Println(ValidNumber(CarrierUPS, "1Z 999 AA1 01 2345 6784"))
+	fmt.Println(ValidNumber(CarrierUPS, "9400111899223856789012"))
+	fmt.Println(ValidNumber(CarrierRoyalMail, "AB123456789GB"))
+	// Output:
+	// true
+	// false
+	// true
+}
+
+func ExampleNormalize() {
+	t := Normalize(order.Shipment{Carrier: "usps", Number: "9400 1118 9922 3856 7890 12"})
+	fmt.Println(t.Carrier, t.CarrierName, t.Number, t.Valid)
+	fmt.Println(t.Url)
+	// Output:
+	// USPS USPS 9400111899223856789012 true
+	// https://tools.usps.com/go/TrackConfirmAction?tLabels=9400111899223856789012
+}
+
+func ExampleSummarize() {
+	orders := []order.Order{
+		{Id: "ord_1", Status: "in-production"},
+		{Id: "ord_2", Status: "fulfilled", Shipments: []order.Shipment{
+			{Carrier: "UPS", Number: "1Z999AA10123456784"},
+		}},
+		{Id: "ord_3", Status: "partially-fulfilled", Shipments: []order.Shipment{
+			{Carrier: "Royal Mail", Number: "AB123456789GB"},
+		}},
+		{Id: "ord_4", Status: "fulfilled", Shipments: []order.Shipment{
+			{Carrier: "fedex", Number: "123456789012", DeliveredAt: timestamp("2024-05-02 10:00:00+00:00")},
+			{Carrier: "fedex", Number: "123456789013"},
+		}},
+		{Id: "ord_5", Status: "fulfilled", Shipments: []order.Shipment{
+			{Carrier: "DHL Express", Number: "1234567890", DeliveredAt: timestamp("2024-05-03 10:00:00+00:00")},
+		}},
+	}
+	for _, o := range orders {
+		s := Summarize(o)
+		fmt.Println(s.OrderId, s.Status, s.Message)
+	}
+	// Output:
+	// ord_1 processing Your order is being prepared.
+	// ord_2 in_transit Your order is on its way with UPS.
+	// ord_3 partially_shipped Part of your order has shipped with Royal Mail. Some items are still being prepared.
+	// ord_4 partially_delivered 1 of 2 packages delivered.
+	// ord_5 delivered Delivered on May 3, 2024.
+}
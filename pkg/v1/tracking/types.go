@@ -0,0 +1,76 @@
+package tracking
+
+import (
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+)
+
+// CarrierEnum is the canonical code of a shipping carrier.
+type CarrierEnum string
+
+const (
+	CarrierUnknown       CarrierEnum = ""
+	CarrierUSPS          CarrierEnum = "USPS"
+	CarrierUPS           CarrierEnum = "UPS"
+	CarrierFedEx         CarrierEnum = "FEDEX"
+	CarrierDHL           CarrierEnum = "DHL"
+	CarrierDHLECommerce  CarrierEnum = "DHL_ECOMMERCE"
+	CarrierRoyalMail     CarrierEnum = "ROYAL_MAIL"
+	CarrierCanadaPost    CarrierEnum = "CANADA_POST"
+	CarrierAustraliaPost CarrierEnum = "AUSTRALIA_POST"
+	CarrierAsendia       CarrierEnum = "ASENDIA"
+)
+
+// Name returns the carrier's display name, or "" for CarrierUnknown.
+func (c CarrierEnum) Name() string {
+	return carriers[c].name
+}
+
+// StatusEnum is a customer-facing delivery status.
+type StatusEnum string
+
+const (
+	// StatusProcessing means nothing has shipped yet.
+	StatusProcessing StatusEnum = "processing"
+	// StatusPartiallyShipped means some items shipped while others are still in production.
+	StatusPartiallyShipped StatusEnum = "partially_shipped"
+	// StatusInTransit means everything has shipped and nothing is delivered yet.
+	StatusInTransit StatusEnum = "in_transit"
+	// StatusPartiallyDelivered means some, but not all, shipments were delivered.
+	StatusPartiallyDelivered StatusEnum = "partially_delivered"
+	// StatusDelivered means every shipment was delivered.
+	StatusDelivered StatusEnum = "delivered"
+	// StatusCanceled means the order was canceled.
+	StatusCanceled StatusEnum = "canceled"
+)
+
+// Tracking is a normalized order.Shipment.
+type Tracking struct {
+	// Carrier is the canonical carrier, or CarrierUnknown when the name is not recognized.
+	Carrier CarrierEnum `json:"carrier"`
+	// CarrierName is the carrier's display name, or the name reported by Printify when unknown.
+	CarrierName string `json:"carrier_name"`
+	Number      string `json:"number"`
+	// Url is the tracking link reported by Printify, or one built from Carrier and Number.
+	Url string `json:"url"`
+	// Valid reports whether Number has a known format for Carrier.
+	Valid       bool             `json:"valid"`
+	DeliveredAt common.Timestamp `json:"delivered_at"`
+}
+
+// Delivered reports whether the shipment was delivered.
+func (t Tracking) Delivered() bool {
+	return !t.DeliveredAt.IsZero()
+}
+
+// Summary is the delivery status of an order.
+type Summary struct {
+	OrderId string     `json:"order_id"`
+	Status  StatusEnum `json:"status"`
+	// Message describes Status for customers.
+	Message   string     `json:"message"`
+	Shipments []Tracking `json:"shipments"`
+	// Delivered is the number of delivered shipments.
+	Delivered int `json:"delivered"`
+	// LastDeliveredAt is the latest delivery time of any shipment.
+	LastDeliveredAt common.Timestamp `json:"last_delivered_at"`
+}
@@ -0,0 +1,141 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+type ruleFunc struct {
+	name string
+	fn   func(o order.Order) Verdict
+}
+
+func (r ruleFunc) Name() string                   { return r.name }
+func (r ruleFunc) Evaluate(o order.Order) Verdict { return r.fn(o) }
+
+// NewRule creates a Rule from a function.
+func NewRule(name string, fn func(o order.Order) Verdict) Rule {
+	return ruleFunc{name: name, fn: fn}
+}
+
+// OrderCost returns what the order costs the merchant: the production cost of every
+// line item plus shipping and tax.
+//
+// TotalShipping is used when set, falling back to the sum of line item shipping costs.
+func OrderCost(o order.Order) common.Money {
+	cost := common.Money{Currency: o.TotalPrice.Currency}
+	shipping := 0
+	for _, li := range o.LineItems {
+		cost.Amount += li.Cost.Amount
+		shipping += li.ShippingCost.Amount
+	}
+	if !o.TotalShipping.IsZero() {
+		shipping = o.TotalShipping.Amount
+	}
+	cost.Amount += shipping + o.TotalTax.Amount
+	return cost
+}
+
+// MaxTotalCost holds orders whose OrderCost exceeds limit. Orders whose cost is in
+// another currency than limit are held too; an empty currency matches any.
+func MaxTotalCost(limit common.Money) Rule {
+	return NewRule("max_total_cost", func(o order.Order) Verdict {
+		cost := OrderCost(o)
+		over, err := cost.Sub(limit)
+		if err != nil {
+			return Hold(err.Error())
+		}
+		if over.Amount > 0 {
+			return Hold(fmt.Sprintf("cost %s exceeds limit %s", cost.Decimal(), limit.Decimal()))
+		}
+		return Approve()
+	})
+}
+
+// BlockedCountries rejects orders shipping to any of the given country codes.
+func BlockedCountries(codes ...string) Rule {
+	blocked := make(map[string]bool, len(codes))
+	for _, c := range codes {
+		blocked[strings.ToUpper(c)] = true
+	}
+	return NewRule("blocked_countries", func(o order.Order) Verdict {
+		country := strings.ToUpper(strings.TrimSpace(o.AddressTo.Country))
+		if blocked[country] {
+			return Reject(fmt.Sprintf("shipping to %s is blocked", country))
+		}
+		return Approve()
+	})
+}
+
+// AddressScorer rates how risky a delivery address is, returning a score and the
+// signals that contributed to it.
+type AddressScorer func(a order.Address) (score int, signals []string)
+
+var poBox = regexp.MustCompile(`(?i)\bp\.?\s*o\.?\s*box\b`)
+
+// DefaultAddressScorer scores an address from simple signals: a missing street, city,
+// zip, country, recipient name or email, and PO box delivery. Each adds one point.
+func DefaultAddressScorer(a order.Address) (int, []string) {
+	var signals []string
+	required := []struct{ name, value string }{
+		{"address1", a.Address1},
+		{"city", a.City},
+		{"zip", a.Zip},
+		{"country", a.Country},
+		{"email", a.Email},
+	}
+	for _, f := range required {
+		if strings.TrimSpace(f.value) == "" {
+			signals = append(signals, "missing "+f.name)
+		}
+	}
+	if strings.TrimSpace(a.FirstName) == "" && strings.TrimSpace(a.LastName) == "" {
+		signals = append(signals, "missing recipient name")
+	}
+	if poBox.MatchString(a.Address1) || poBox.MatchString(a.Address2) {
+		signals = append(signals, "PO box")
+	}
+	return len(signals), signals
+}
+
+// AddressRisk holds orders whose address scores at least threshold with scorer.
+// A nil scorer uses DefaultAddressScorer.
+func AddressRisk(scorer AddressScorer, threshold int) Rule {
+	if scorer == nil {
+		scorer = DefaultAddressScorer
+	}
+	return NewRule("address_risk", func(o order.Order) Verdict {
+		score, signals := scorer(o.AddressTo)
+		if score >= threshold {
+			return Hold(fmt.Sprintf("address risk %d: %s", score, strings.Join(signals, ", ")))
+		}
+		return Approve()
+	})
+}
+
+// HoldTags holds orders tagged with any of tags.
+//
+// Printify orders carry no tags, so tagsFor looks them up wherever they are kept,
+// for example in the storefront by Order.ExternalId. tagsFor is required.
+func HoldTags(tagsFor func(o order.Order) []string, tags ...string) (Rule, error) {
+	if tagsFor == nil {
+		return nil, errors.New("hold_tags: tagsFor is required")
+	}
+	holding := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		holding[strings.ToLower(t)] = true
+	}
+	return NewRule("hold_tags", func(o order.Order) Verdict {
+		for _, t := range tagsFor(o) {
+			if holding[strings.ToLower(t)] {
+				return Hold("tagged " + t)
+			}
+		}
+		return Approve()
+	}), nil
+}
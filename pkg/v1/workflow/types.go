@@ -0,0 +1,131 @@
+package workflow
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+// VerdictEnum is the result of evaluating a Rule against an order.
+type VerdictEnum string
+
+const (
+	// VerdictApprove lets the order continue to production.
+	VerdictApprove VerdictEnum = "approve"
+	// VerdictHold keeps the order on hold until the hold is lifted.
+	VerdictHold VerdictEnum = "hold"
+	// VerdictReject keeps the order out of production permanently.
+	VerdictReject VerdictEnum = "reject"
+)
+
+// Verdict is a rule's decision about an order.
+type Verdict struct {
+	Result VerdictEnum
+	// Reason explains a hold or rejection.
+	Reason string
+}
+
+// Approve returns an approving Verdict.
+func Approve() Verdict {
+	return Verdict{Result: VerdictApprove}
+}
+
+// Hold returns a Verdict that holds the order for reason.
+func Hold(reason string) Verdict {
+	return Verdict{Result: VerdictHold, Reason: reason}
+}
+
+// Reject returns a Verdict that rejects the order for reason.
+func Reject(reason string) Verdict {
+	return Verdict{Result: VerdictReject, Reason: reason}
+}
+
+// Rule is an approval check run against every order before it is sent to production.
+type Rule interface {
+	// Name identifies the rule in recorded decisions.
+	Name() string
+	Evaluate(o order.Order) Verdict
+}
+
+// OutcomeEnum is what the workflow did with an order.
+type OutcomeEnum string
+
+const (
+	// OutcomeApproved means every rule approved the order and it is due to be sent.
+	OutcomeApproved OutcomeEnum = "approved"
+	// OutcomeSent means the order was approved and sent to production.
+	OutcomeSent OutcomeEnum = "sent"
+	// OutcomePending means the order was approved but is waiting for its release window.
+	OutcomePending OutcomeEnum = "pending"
+	// OutcomeHeld means a rule held the order.
+	OutcomeHeld OutcomeEnum = "held"
+	// OutcomeRejected means a rule rejected the order.
+	OutcomeRejected OutcomeEnum = "rejected"
+	// OutcomeFailed means the order was approved but SendOrderToProduction returned an error.
+	OutcomeFailed OutcomeEnum = "failed"
+)
+
+// Decision records the workflow's decision about an order.
+type Decision struct {
+	OrderId string      `json:"order_id"`
+	Outcome OutcomeEnum `json:"outcome"`
+	// Rule names the rule that held or rejected the order.
+	Rule string `json:"rule,omitempty"`
+	// Reason is the rule's explanation, or the send error for failed orders.
+	Reason    string    `json:"reason,omitempty"`
+	DecidedAt time.Time `json:"decided_at"`
+}
+
+// Final reports whether the order needs no further processing.
+func (d Decision) Final() bool {
+	return d.Outcome == OutcomeSent || d.Outcome == OutcomeRejected
+}
+
+// Store records decisions.
+//
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Save records d, replacing any earlier decision for the same order.
+	Save(d Decision) error
+	// Get returns the latest decision for an order. The bool is false when none was recorded.
+	Get(orderId string) (Decision, bool, error)
+}
+
+// MemoryStore is an in-memory Store.
+type MemoryStore struct {
+	mu        sync.Mutex
+	decisions map[string]Decision
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{decisions: map[string]Decision{}}
+}
+
+func (s *MemoryStore) Save(d Decision) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.decisions[d.OrderId] = d
+	return nil
+}
+
+func (s *MemoryStore) Get(orderId string) (Decision, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d, ok := s.decisions[orderId]
+	return d, ok, nil
+}
+
+// Decisions returns every recorded decision sorted by order id.
+func (s *MemoryStore) Decisions() []Decision {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Decision, 0, len(s.decisions))
+	for _, d := range s.decisions {
+		out = append(out, d)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].OrderId < out[j].OrderId })
+	return out
+}
@@ -0,0 +1,183 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+// OnHoldStatus is the status of orders waiting to be sent to production.
+const OnHoldStatus = "on-hold"
+
+// Workflow sends orders to production once they pass every approval rule.
+//
+// Orders are expected to have been created as drafts, i.e. submitted without being
+// sent to production, so they wait in the "on-hold" status.
+type Workflow struct {
+	Orders order.Client
+	ShopId int
+	Rules  []Rule
+	// Store records every decision. Orders with a sent or rejected decision are not evaluated again.
+	// Defaults to a MemoryStore.
+	Store Store
+	// BatchSize is the number of approved orders sent to production concurrently. Defaults to 10.
+	BatchSize int
+	// ReleaseAfter delays approved orders until they are at least this old, measured from
+	// Order.CreatedAt, leaving time to hold them manually. Zero sends approved orders immediately.
+	ReleaseAfter time.Duration
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	init sync.Once
+}
+
+// NewWorkflow creates a Workflow with a MemoryStore.
+func NewWorkflow(orders order.Client, shopId int, rules ...Rule) *Workflow {
+	return &Workflow{
+		Orders: orders,
+		ShopId: shopId,
+		Rules:  rules,
+		Store:  NewMemoryStore(),
+	}
+}
+
+// Evaluate runs every rule against an order without sending it.
+//
+// A rejection takes precedence over a hold; otherwise the first rule to hold the
+// order is reported. An approved order is OutcomePending while it is younger than
+// ReleaseAfter and OutcomeApproved once it is due to be sent.
+func (w *Workflow) Evaluate(o order.Order) Decision {
+	now := w.now()
+	d := Decision{OrderId: o.Id, DecidedAt: now}
+	var held *Decision
+	for _, r := range w.Rules {
+		v := r.Evaluate(o)
+		switch v.Result {
+		case VerdictReject:
+			d.Outcome, d.Rule, d.Reason = OutcomeRejected, r.Name(), v.Reason
+			return d
+		case VerdictHold:
+			if held == nil {
+				held = &Decision{OrderId: o.Id, Outcome: OutcomeHeld, Rule: r.Name(), Reason: v.Reason, DecidedAt: now}
+			}
+		}
+	}
+	if held != nil {
+		return *held
+	}
+
+	d.Outcome = OutcomeApproved
+	if w.ReleaseAfter > 0 {
+		switch due := o.CreatedAt.Add(w.ReleaseAfter); {
+		case o.CreatedAt.IsZero():
+			d.Outcome, d.Reason = OutcomePending, "created_at is not set"
+		case now.Before(due):
+			d.Outcome, d.Reason = OutcomePending, fmt.Sprintf("release after %s", due.Format(time.RFC3339))
+		}
+	}
+	return d
+}
+
+// Process evaluates orders, sends the approved ones to production in batches and
+// records every decision in Store.
+//
+// Orders already sent or rejected according to Store are skipped. Decisions are
+// returned in the order of orders. The error is only set when Store fails; send
+// failures are reported as OutcomeFailed decisions and retried on the next call.
+func (w *Workflow) Process(orders []order.Order) ([]Decision, error) {
+	w.defaults()
+	decisions := make([]Decision, 0, len(orders))
+	var due []int
+	for _, o := range orders {
+		prev, ok, err := w.Store.Get(o.Id)
+		if err != nil {
+			return nil, err
+		}
+		if ok && prev.Final() {
+			continue
+		}
+		d := w.Evaluate(o)
+		if d.Outcome == OutcomeApproved {
+			due = append(due, len(decisions))
+		}
+		decisions = append(decisions, d)
+	}
+
+	size := w.BatchSize
+	if size <= 0 {
+		size = 10
+	}
+	for start := 0; start < len(due); start += size {
+		var wg sync.WaitGroup
+		for _, i := range due[start:min(start+size, len(due))] {
+			wg.Add(1)
+			go func(d *Decision) {
+				defer wg.Done()
+				d.Outcome = OutcomeSent
+				if err := w.Orders.SendOrderToProduction(w.ShopId, d.OrderId, order.Order{}); err != nil {
+					d.Outcome, d.Reason = OutcomeFailed, err.Error()
+				}
+			}(&decisions[i])
+		}
+		wg.Wait()
+	}
+
+	for _, d := range decisions {
+		if err := w.Store.Save(d); err != nil {
+			return decisions, err
+		}
+	}
+	return decisions, nil
+}
+
+// Release fetches the shop's on-hold orders and processes them.
+func (w *Workflow) Release() ([]Decision, error) {
+	orders, err := w.Orders.QueryOrders(order.ListOrdersOptions{Status: OnHoldStatus})
+	if err != nil {
+		return nil, err
+	}
+	return w.Process(orders)
+}
+
+// Run calls Release immediately and then every interval until ctx is done, which
+// gives the scheduled "auto-release after ReleaseAfter unless held" mode.
+//
+// report, when not nil, receives the result of each Release. Run returns ctx.Err(),
+// or an error without calling Release when interval is not positive.
+func (w *Workflow) Run(ctx context.Context, interval time.Duration, report func(decisions []Decision, err error)) error {
+	if interval <= 0 {
+		return fmt.Errorf("interval %s is not positive", interval)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		decisions, err := w.Release()
+		if report != nil {
+			report(decisions, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// defaults fills in Store on first use, so a Workflow literal without one works.
+func (w *Workflow) defaults() {
+	w.init.Do(func() {
+		if w.Store == nil {
+			w.Store = NewMemoryStore()
+		}
+	})
+}
+
+func (w *Workflow) now() time.Time {
+	if w.Now != nil {
+		return w.Now()
+	}
+	return time.Now()
+}
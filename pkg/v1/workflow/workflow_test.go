@@ -0,0 +1,114 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/connellrobert/printify-go/pkg/common"
+	v1common "github.com/connellrobert/printify-go/pkg/v1/common"
+	"github.com/connellrobert/printify-go/pkg/v1/order"
+)
+
+const onHoldOrders = `{"current_page":1,"last_page":1,"data":[
+{"id":"ord_1","status":"on-hold","created_at":"2024-05-01 08:00:00+00:00","total_shipping":400,"line_items":[{"cost":1200}],"address_to":{"first_name":"Ada","address1":"1 Main St","city":"Springfield","zip":"12345","country":"US","email":"ada@example.com"}},
+{"id":"ord_2","status":"on-hold","created_at":"2024-05-01 08:00:00+00:00","total_shipping":400,"line_items":[{"cost":9900}],"address_to":{"first_name":"Alan","address1":"2 High St","city":"London","zip":"N1","country":"GB","email":"alan@example.com"}},
+{"id":"ord_3","status":"on-hold","created_at":"2024-05-01 08:00:00+00:00","line_items":[{"cost":1200}],"address_to":{"first_name":"Kim","address1":"3 Rd","city":"X","zip":"1","country":"KP","email":"kim@example.com"}},
+{"id":"ord_4","status":"on-hold","created_at":"2024-05-01 11:00:00+00:00","line_items":[{"cost":1200}],"address_to":{"first_name":"Grace","address1":"PO Box 7","city":"Arlington","zip":"22201","country":"US"}},
+{"id":"ord_5","status":"on-hold","created_at":"2024-05-01 08:00:00+00:00","line_items":[{"cost":1200}],"address_to":{"first_name":"Linus","address1":"5 Elm","city":"Helsinki","zip":"00100","country":"FI","email":"linus@example.com"}}
+]}`
+
+func newWorkflowTestClient(register func(mux *http.ServeMux)) (*common.Client, func()) {
+	mux := http.NewServeMux()
+	register(mux)
+	srv := httptest.NewServer(mux)
+	c := common.NewClient("printify_pat", 123)
+	c.Host = srv.URL
+	return c, srv.Close
+}
+
+func ExampleWorkflow_Release() {
+	var sent []string
+	c, closeFn := newWorkflowTestClient(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v1/shops/123/orders.json", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(onHoldOrders))
+		})
+		mux.HandleFunc("/v1/shops/123/orders/", func(w http.ResponseWriter, r *http.Request) {
+			sent = append(sent, strings.Split(r.URL.Path, "/")[5])
+		})
+	})
+	defer closeFn()
+
+	tags := map[string][]string{"ord_5": {"manual-review"}}
+	holdTags, _ := HoldTags(func(o order.Order) []string { return tags[o.Id] }, "manual-review")
+	wf := NewWorkflow(order.NewClient(c), 123,
+		MaxTotalCost(v1common.NewMoney(5000, "USD")),
+		BlockedCountries("KP"),
+		AddressRisk(nil, 2),
+		holdTags,
+	)
+	wf.BatchSize = 1
+	wf.ReleaseAfter = 2 * time.Hour
+	wf.Now = func() time.Time { return time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC) }
+
+	decisions, _ := wf.Release()
+	for _, d := range decisions {
+		fmt.Println(strings.TrimSpace(fmt.Sprint(d.OrderId, " ", d.Outcome, " ", d.Rule, " ", d.Reason)))
+	}
+	fmt.Println("sent:", sent)
+
+	// Releasing again skips orders that were already sent or rejected.
+	decisions, _ = wf.Release()
+	fmt.Println("re-evaluated:", len(decisions))
+	// Output:
+	// ord_1 sent
+	// ord_2 held max_total_cost cost 103.00 exceeds limit 50.00
+	// ord_3 rejected blocked_countries shipping to KP is blocked
+	// ord_4 held address_risk address risk 2: missing email, PO box
+	// ord_5 held hold_tags tagged manual-review
+	// sent: [ord_1]
+	// re-evaluated: 3
+}
+
+func ExampleWorkflow_Evaluate() {
+	wf := NewWorkflow(nil, 123, AddressRisk(nil, 2))
+	wf.ReleaseAfter = 6 * time.Hour
+	wf.Now = func() time.Time { return time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC) }
+
+	created, _ := v1common.ParseTimestamp("2024-05-01 10:00:00+00:00")
+	d := wf.Evaluate(order.Order{Id: "ord_1", CreatedAt: created, AddressTo: order.Address{
+		FirstName: "Ada", Address1: "1 Main St", City: "Springfield", Zip: "12345", Country: "US",
+	}})
+	fmt.Println(d.Outcome, d.Reason)
+	// Output: pending release after 2024-05-01T16:00:00Z
+}
+
+func ExampleWorkflow_Run() {
+	wf := NewWorkflow(nil, 123)
+	fmt.Println(wf.Run(context.Background(), 0, nil))
+
+	_, err := HoldTags(nil, "manual-review")
+	fmt.Println(err)
+	// Output:
+	// interval 0s is not positive
+	// hold_tags: tagsFor is required
+}
+
+func ExampleWorkflow_Process() {
+	wf := &Workflow{Rules: []Rule{MaxTotalCost(v1common.NewMoney(10000, "EUR"))}}
+	decisions, err := wf.Process([]order.Order{
+		{Id: "ord_1", TotalPrice: v1common.NewMoney(0, "GBP"), LineItems: []order.LineItem{{Cost: v1common.NewMoney(9000, "")}}},
+		{Id: "ord_2", TotalPrice: v1common.NewMoney(0, "EUR"), LineItems: []order.LineItem{{Cost: v1common.NewMoney(11000, "")}}},
+	})
+	for _, d := range decisions {
+		fmt.Println(d.OrderId, d.Outcome, d.Reason)
+	}
+	fmt.Println(err)
+	// Output:
+	// ord_1 held currency mismatch: GBP and EUR
+	// ord_2 held cost 110.00 exceeds limit 100.00
+	// <nil>
+}
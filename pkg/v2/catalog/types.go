@@ -1,5 +1,9 @@
 package catalog
 
+import (
+	"github.com/connellrobert/printify-go/pkg/v1/common"
+)
+
 // ShippingListAttributeCountry represents a country object in v2 shipping attributes.
 type ShippingListAttributeCountry struct {
 	Code string `json:"code,omitempty"`
@@ -74,3 +78,8 @@ type Item struct {
 	Amount   int    `json:"amount"`
 	Currency string `json:"currency"`
 }
+
+// Money returns the amount and currency as a common.Money value.
+func (i Item) Money() common.Money {
+	return common.NewMoney(i.Amount, i.Currency)
+}